@@ -0,0 +1,512 @@
+package engine
+
+import "log/slog"
+
+// ParadoxRule selects how a convoy paradox is resolved.
+type ParadoxRule int8
+
+const (
+	// SzykmanRule lets the convoyed armies involved in a paradox hold. Their
+	// moves have no effect on the remaining orders.
+	SzykmanRule ParadoxRule = iota
+	// AllHoldRule lets all units involved in a paradox hold, as described in
+	// the 2000 rulebook.
+	AllHoldRule
+)
+
+type resolutionState int8
+
+const (
+	unresolved resolutionState = iota
+	guessing
+	resolved
+)
+
+// resolver adjudicates all orders of a movement phase simultaneously. It
+// follows the guess and check algorithm described in the DATC (section 5):
+// every order is resolved recursively and cyclic dependencies are broken by
+// trying both outcomes and applying a backup rule if both are consistent.
+type resolver struct {
+	world       *Graph
+	paradoxRule ParadoxRule
+	logger      *slog.Logger
+	orders      []Order
+	orderAt     map[*Province]Order
+	movesTo     map[*Province][]*MoveOrder
+	convoyed    map[*MoveOrder]bool
+	paradoxical map[*MoveOrder]bool
+	void        map[*Province]Order
+	defaulted   map[Order]bool
+	state       map[Order]resolutionState
+	resolution  map[Order]bool
+	deps        []Order
+}
+
+func newResolver(world *Graph, orders []Order, paradoxRule ParadoxRule, logger *slog.Logger) *resolver {
+	r := &resolver{
+		world:       world,
+		paradoxRule: paradoxRule,
+		logger:      logger,
+		orders:      []Order{},
+		orderAt:     map[*Province]Order{},
+		movesTo:     map[*Province][]*MoveOrder{},
+		convoyed:    map[*MoveOrder]bool{},
+		paradoxical: map[*MoveOrder]bool{},
+		void:        map[*Province]Order{},
+		defaulted:   map[Order]bool{},
+		state:       map[Order]resolutionState{},
+		resolution:  map[Order]bool{},
+	}
+
+	for _, order := range orders {
+		position := order.GetPosition()
+		if position == nil || position.Unit == nil {
+			continue
+		}
+		if move, ok := order.(*MoveOrder); ok && !r.isLegalMove(move) {
+			r.logger.Info("Illegal move holds", "order", order)
+			r.void[position] = order
+			continue
+		}
+		r.orderAt[position] = order
+	}
+
+	// units without a (valid) order hold
+	for _, key := range sortedProvinceKeys(world) {
+		p := world.Provinces[key]
+		if p.Unit == nil {
+			continue
+		}
+		if _, ok := r.orderAt[p]; !ok {
+			r.orderAt[p] = &HoldOrder{Position: p}
+			r.defaulted[r.orderAt[p]] = r.void[p] == nil
+		}
+		order := r.orderAt[p]
+		r.orders = append(r.orders, order)
+		if move, ok := order.(*MoveOrder); ok {
+			r.movesTo[move.Destination] = append(r.movesTo[move.Destination], move)
+		}
+	}
+
+	for _, order := range r.orders {
+		if move, ok := order.(*MoveOrder); ok {
+			r.convoyed[move] = r.isConvoyed(move)
+		}
+	}
+
+	return r
+}
+
+// isLegalMove checks whether the unit can reach the destination at all,
+// either directly or, for armies, by a convoy to a land province. Fleets
+// cannot be convoyed.
+func (r *resolver) isLegalMove(move *MoveOrder) bool {
+	unit := move.Position.Unit
+	if move.Destination == move.Position || (unit.Type == Fleet && move.ViaConvoy) {
+		return false
+	}
+	if r.world.canMoveTo(unit, move.Position, move.Destination, move.Coast) {
+		return true
+	}
+	return unit.Type == Army && move.Destination.Type == LandTile
+}
+
+// isConvoyed decides whether an army moves by convoy. An army moving to an
+// adjacent province only uses a convoy if it is ordered to do so or if one of
+// its own fleets is part of a convoy route to the destination.
+func (r *resolver) isConvoyed(move *MoveOrder) bool {
+	unit := move.Position.Unit
+	if unit.Type != Army {
+		return false
+	}
+	if !r.world.CanMove(Army, move.Position, move.Destination) || move.ViaConvoy {
+		return true
+	}
+
+	fromSource := r.convoyChain(move, move.Position)
+	fromDestination := r.convoyChain(move, move.Destination)
+	for province := range fromSource {
+		if fromDestination[province] && province.Unit.Country == unit.Country {
+			return true
+		}
+	}
+	return false
+}
+
+// convoyChain returns the provinces of all fleets convoying the move that
+// are connected to the start by a chain of convoying fleets.
+func (r *resolver) convoyChain(move *MoveOrder, start *Province) map[*Province]bool {
+	chain := map[*Province]bool{}
+	queue := []*Province{start}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, edge := range current.Edges {
+			next := edge.Province
+			convoy, ok := r.orderAt[next].(*ConvoyOrder)
+			if chain[next] || !ok || !isValidConvoyOrder(move, convoy) {
+				continue
+			}
+			chain[next] = true
+			queue = append(queue, next)
+		}
+	}
+
+	return chain
+}
+
+// resolveAll resolves every order and returns the successful moves.
+func (r *resolver) resolveAll() []*MoveOrder {
+	moves := []*MoveOrder{}
+	for _, order := range r.orders {
+		success := r.resolve(order)
+		r.logger.Debug("OrderResolved", "order", order, "success", success)
+		if move, ok := order.(*MoveOrder); ok && success {
+			moves = append(moves, move)
+		}
+	}
+	return moves
+}
+
+// bounced returns the moves that failed although they could reach their
+// destination.
+func (r *resolver) bounced() []*MoveOrder {
+	moves := []*MoveOrder{}
+	for _, order := range r.orders {
+		if move, ok := order.(*MoveOrder); ok && !r.resolve(move) && r.hasPath(move) {
+			moves = append(moves, move)
+		}
+	}
+	return moves
+}
+
+func (r *resolver) resolve(order Order) bool {
+	switch r.state[order] {
+	case resolved:
+		return r.resolution[order]
+	case guessing:
+		for _, dep := range r.deps {
+			if dep == order {
+				return r.resolution[order]
+			}
+		}
+		r.deps = append(r.deps, order)
+		return r.resolution[order]
+	}
+
+	depCount := len(r.deps)
+
+	r.resolution[order] = false
+	r.state[order] = guessing
+	first := r.adjudicate(order)
+
+	if len(r.deps) == depCount {
+		// the outcome did not depend on any guess
+		if r.state[order] != resolved {
+			r.resolution[order] = first
+			r.state[order] = resolved
+		}
+		return first
+	}
+
+	if r.deps[depCount] != order {
+		// part of a cycle that is started by another order
+		r.deps = append(r.deps, order)
+		r.resolution[order] = first
+		return first
+	}
+
+	// this order starts a cycle, check the other guess as well
+	r.resetDeps(depCount)
+	r.resolution[order] = true
+	r.state[order] = guessing
+	second := r.adjudicate(order)
+
+	if first == second {
+		r.resetDeps(depCount)
+		r.resolution[order] = first
+		r.state[order] = resolved
+		return first
+	}
+
+	r.logger.Debug("BackupRuleApplied", "order", order, "cycle", len(r.deps)-depCount)
+	r.backupRule(depCount)
+	return r.resolve(order)
+}
+
+func (r *resolver) resetDeps(count int) {
+	for _, dep := range r.deps[count:] {
+		r.state[dep] = unresolved
+	}
+	r.deps = r.deps[:count]
+}
+
+// backupRule settles a cycle in which both guesses are consistent. A cycle
+// consisting of moves only is a circular movement, in which every move
+// succeeds. Any other cycle is a convoy paradox, which is settled by the
+// configured paradox rule.
+func (r *resolver) backupRule(count int) {
+	cycle := r.deps[count:]
+	r.deps = r.deps[:count]
+
+	circular := true
+	for _, dep := range cycle {
+		if _, ok := dep.(*MoveOrder); !ok {
+			circular = false
+		}
+	}
+
+	for _, dep := range cycle {
+		switch {
+		case circular:
+			r.resolution[dep] = true
+			r.state[dep] = resolved
+		case r.paradoxRule == AllHoldRule:
+			r.resolution[dep] = false
+			r.state[dep] = resolved
+		default:
+			r.state[dep] = unresolved
+		}
+	}
+
+	if circular || r.paradoxRule != SzykmanRule {
+		return
+	}
+
+	// the armies convoyed by the fleets in the paradox do not move
+	settled := false
+	for _, dep := range cycle {
+		var move *MoveOrder
+		switch o := dep.(type) {
+		case *MoveOrder:
+			move = o
+		case *ConvoyOrder:
+			move, _ = r.orderAt[o.Source].(*MoveOrder)
+		}
+		if move != nil && r.convoyed[move] && !r.paradoxical[move] {
+			r.paradoxical[move] = true
+			r.resolution[move] = false
+			r.state[move] = resolved
+			settled = true
+		}
+	}
+
+	// should no convoyed army be involved, fall back to letting all units
+	// hold to guarantee that the resolution terminates
+	if !settled {
+		for _, dep := range cycle {
+			r.resolution[dep] = false
+			r.state[dep] = resolved
+		}
+	}
+}
+
+func (r *resolver) adjudicate(order Order) bool {
+	switch o := order.(type) {
+	case *MoveOrder:
+		return r.adjudicateMove(o)
+	case *SupportOrder:
+		return r.adjudicateSupport(o)
+	case *ConvoyOrder:
+		return r.adjudicateConvoy(o)
+	default:
+		return true
+	}
+}
+
+func (r *resolver) adjudicateMove(move *MoveOrder) bool {
+	if !r.hasPath(move) {
+		return false
+	}
+
+	attack := r.attackStrength(move)
+
+	if opposing := r.headToHead(move); opposing != nil {
+		if attack <= r.defendStrength(opposing) {
+			return false
+		}
+	} else if attack <= r.holdStrength(move.Destination) {
+		return false
+	}
+
+	for _, other := range r.movesTo[move.Destination] {
+		if other != move && attack <= r.preventStrength(other) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// adjudicateSupport decides whether a support is given. A support is cut by
+// any attack of another country, unless the attack comes from the province
+// the support is directed against. In that case only a dislodgement cuts it.
+func (r *resolver) adjudicateSupport(support *SupportOrder) bool {
+	supporter := support.Position.Unit.Country
+
+	for _, attack := range r.movesTo[support.Position] {
+		if attack.Position.Unit.Country == supporter || !r.hasPath(attack) {
+			continue
+		}
+		if attack.Position != support.Destination {
+			return false
+		}
+		if r.resolve(attack) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// adjudicateConvoy decides whether a convoying fleet keeps its position. A
+// convoy is disrupted when the fleet is dislodged.
+func (r *resolver) adjudicateConvoy(convoy *ConvoyOrder) bool {
+	for _, attack := range r.movesTo[convoy.Position] {
+		if r.resolve(attack) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasPath checks whether a move can reach its destination. Moves over land
+// always can, convoyed moves need a chain of convoying fleets that are not
+// disrupted.
+func (r *resolver) hasPath(move *MoveOrder) bool {
+	if !r.convoyed[move] {
+		return true
+	}
+	if r.paradoxical[move] {
+		return false
+	}
+	return r.findPath(move, func(convoy *ConvoyOrder) bool {
+		return r.resolve(convoy)
+	})
+}
+
+// findPath searches a chain of fleets convoying the move from its position to
+// its destination. Only convoys accepted by the filter are used.
+func (r *resolver) findPath(move *MoveOrder, accept func(*ConvoyOrder) bool) bool {
+	visited := map[*Province]bool{}
+	queue := []*Province{move.Position}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, key := range sortedEdgeKeys(current) {
+			next := current.Edges[key].Province
+			if next == move.Destination && current != move.Position {
+				return true
+			}
+			if visited[next] {
+				continue
+			}
+			visited[next] = true
+
+			convoy, ok := r.orderAt[next].(*ConvoyOrder)
+			if !ok || !isValidConvoyOrder(move, convoy) || !accept(convoy) {
+				continue
+			}
+			queue = append(queue, next)
+		}
+	}
+
+	return false
+}
+
+// headToHead returns the move of the unit at the destination if it is moving
+// directly against the given move. Units exchanging places by convoy do not
+// meet each other.
+func (r *resolver) headToHead(move *MoveOrder) *MoveOrder {
+	if r.convoyed[move] {
+		return nil
+	}
+	if opposing, ok := r.orderAt[move.Destination].(*MoveOrder); ok && opposing.Destination == move.Position && !r.convoyed[opposing] {
+		return opposing
+	}
+	return nil
+}
+
+func (r *resolver) holdStrength(province *Province) int {
+	order, ok := r.orderAt[province]
+	if !ok {
+		return 0
+	}
+
+	if move, ok := order.(*MoveOrder); ok {
+		if r.resolve(move) {
+			return 0
+		}
+		return 1
+	}
+
+	return r.strength(&HoldOrder{Position: province}, nil)
+}
+
+func (r *resolver) attackStrength(move *MoveOrder) int {
+	if !r.hasPath(move) {
+		return 0
+	}
+
+	order, ok := r.orderAt[move.Destination]
+	if !ok {
+		return r.strength(move, nil)
+	}
+
+	if leaving, ok := order.(*MoveOrder); ok && r.headToHead(move) == nil && r.resolve(leaving) {
+		return r.strength(move, nil)
+	}
+
+	defender := move.Destination.Unit.Country
+	if defender == move.Position.Unit.Country {
+		return 0
+	}
+
+	return r.strength(move, defender)
+}
+
+func (r *resolver) defendStrength(move *MoveOrder) int {
+	return r.strength(move, nil)
+}
+
+func (r *resolver) preventStrength(move *MoveOrder) int {
+	if !r.hasPath(move) {
+		return 0
+	}
+	if opposing := r.headToHead(move); opposing != nil && r.resolve(opposing) {
+		return 0
+	}
+	return r.strength(move, nil)
+}
+
+// strength calculates the strength of an order counting only successful
+// supports. Supports given by units of the excluded country are ignored.
+func (r *resolver) strength(order Order, exclude *Country) int {
+	neighbors, err := r.world.GetNeighborsWithUnits(order.GetDestination())
+	if err != nil {
+		return 0
+	}
+
+	supporters := []*Province{}
+	for _, n := range neighbors {
+		support, ok := r.orderAt[n].(*SupportOrder)
+		if !ok || !isValidSupportOrder(order, support, *n.Unit) || !r.world.CanMoveCoast(n.Unit.Type, n, n.Unit.Coast, order.GetDestination(), NoCoast) {
+			continue
+		}
+		if exclude != nil && n.Unit.Country == exclude {
+			continue
+		}
+		if r.resolve(support) {
+			r.logger.Debug("SupportCounted", "support", support, "order", order)
+			supporters = append(supporters, n)
+		}
+	}
+
+	strength := 1 + len(supporters)
+	r.logger.Debug("StrengthComputed", "order", order, "strength", strength)
+	return strength
+}
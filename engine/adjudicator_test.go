@@ -0,0 +1,713 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testUnit struct {
+	country  string
+	unitType UnitType
+	province string
+}
+
+func setupAdjudicationState(units ...testUnit) *State {
+	state := &State{
+		Year:  1901,
+		Turn:  Spring,
+		Phase: OrderPhase,
+		Countries: []*Country{
+			{Name: "Austria"}, {Name: "England"}, {Name: "France"}, {Name: "Germany"},
+			{Name: "Italy"}, {Name: "Russia"}, {Name: "Turkey"},
+		},
+		World: initializeWorld(),
+	}
+
+	for _, u := range units {
+		country, _ := state.GetCountry(u.country)
+		state.World.AddUnit(country, u.unitType, u.province)
+	}
+
+	return state
+}
+
+// addUncheckedOrder gives an order without validating it, like an illegal
+// order that reaches the adjudicator.
+func addUncheckedOrder(s *State, country string, order Order) {
+	c, _ := s.GetCountry(country)
+	order.GetPosition().Unit.Order = order
+	c.orders = append(c.orders, order)
+}
+
+// adjudicate adjudicates the current phase and fails the test on errors.
+func adjudicate(t *testing.T, s *State) *Report {
+	report, err := s.Adjudicate()
+	assert.NoError(t, err)
+	return report
+}
+
+func assertUnitAt(t *testing.T, s *State, province, country string, unitType UnitType) {
+	p, err := s.World.GetProvince(province)
+	assert.NoError(t, err)
+	if assert.NotNil(t, p.Unit, "Expected a unit in %s", province) {
+		assert.Equal(t, country, p.Unit.Country.Name, "Unexpected owner of unit in %s", province)
+		assert.Equal(t, unitType, p.Unit.Type, "Unexpected unit type in %s", province)
+	}
+}
+
+func assertEmpty(t *testing.T, s *State, province string) {
+	p, err := s.World.GetProvince(province)
+	assert.NoError(t, err)
+	assert.Nil(t, p.Unit, "Expected %s to be empty", province)
+}
+
+func TestAdjudicate_MoveIntoVacatedProvince(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Germany", Army, "Mun"},
+		testUnit{"Germany", Army, "Ber"},
+	)
+
+	// Berlin is processed before Munich is vacated
+	assert.NoError(t, s.AddMoveOrder("Germany", "Ber", "Mun"))
+	assert.NoError(t, s.AddMoveOrder("Germany", "Mun", "Boh"))
+
+	adjudicate(t, s)
+
+	assertEmpty(t, s, "Ber")
+	assertUnitAt(t, s, "Mun", "Germany", Army)
+	assertUnitAt(t, s, "Boh", "Germany", Army)
+}
+
+func TestAdjudicate_MoveIntoFailedMoveBounces(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Germany", Army, "Ber"},
+		testUnit{"Germany", Army, "Mun"},
+		testUnit{"Austria", Army, "Tyr"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("Germany", "Ber", "Mun"))
+	assert.NoError(t, s.AddMoveOrder("Germany", "Mun", "Tyr"))
+
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Ber", "Germany", Army)
+	assertUnitAt(t, s, "Mun", "Germany", Army)
+	assertUnitAt(t, s, "Tyr", "Austria", Army)
+}
+
+func TestAdjudicate_Standoff(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Germany", Army, "Mun"},
+		testUnit{"Austria", Army, "Boh"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("Germany", "Mun", "Sil"))
+	assert.NoError(t, s.AddMoveOrder("Austria", "Boh", "Sil"))
+
+	adjudicate(t, s)
+
+	assertEmpty(t, s, "Sil")
+	assertUnitAt(t, s, "Mun", "Germany", Army)
+	assertUnitAt(t, s, "Boh", "Austria", Army)
+}
+
+func TestAdjudicate_SupportedMoveWinsStandoff(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Germany", Army, "Mun"},
+		testUnit{"Germany", Army, "Ber"},
+		testUnit{"Austria", Army, "Boh"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("Germany", "Mun", "Sil"))
+	assert.NoError(t, s.AddSupportOrder("Germany", "Ber", "Mun", "Sil"))
+	assert.NoError(t, s.AddMoveOrder("Austria", "Boh", "Sil"))
+
+	adjudicate(t, s)
+
+	assertEmpty(t, s, "Mun")
+	assertUnitAt(t, s, "Sil", "Germany", Army)
+	assertUnitAt(t, s, "Boh", "Austria", Army)
+}
+
+func TestAdjudicate_SwapWithoutConvoyBounces(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Germany", Army, "Mun"},
+		testUnit{"Austria", Army, "Boh"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("Germany", "Mun", "Boh"))
+	assert.NoError(t, s.AddMoveOrder("Austria", "Boh", "Mun"))
+
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Mun", "Germany", Army)
+	assertUnitAt(t, s, "Boh", "Austria", Army)
+}
+
+func TestAdjudicate_HeadToHeadWithSupportDislodges(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Germany", Army, "Mun"},
+		testUnit{"Germany", Army, "Sil"},
+		testUnit{"Austria", Army, "Boh"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("Germany", "Mun", "Boh"))
+	assert.NoError(t, s.AddSupportOrder("Germany", "Sil", "Mun", "Boh"))
+	assert.NoError(t, s.AddMoveOrder("Austria", "Boh", "Mun"))
+
+	adjudicate(t, s)
+
+	assertEmpty(t, s, "Mun")
+	assertUnitAt(t, s, "Boh", "Germany", Army)
+}
+
+func TestAdjudicate_SupportedAttackDislodgesHoldingUnit(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Austria", Army, "Vie"},
+		testUnit{"Austria", Army, "Bud"},
+		testUnit{"Italy", Army, "Tri"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("Austria", "Vie", "Tri"))
+	assert.NoError(t, s.AddSupportOrder("Austria", "Bud", "Vie", "Tri"))
+	assert.NoError(t, s.AddHoldOrder("Italy", "Tri"))
+
+	adjudicate(t, s)
+
+	assertEmpty(t, s, "Vie")
+	assertUnitAt(t, s, "Tri", "Austria", Army)
+}
+
+func TestAdjudicate_HoldSupportPreventsDislodgement(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Austria", Army, "Vie"},
+		testUnit{"Austria", Army, "Bud"},
+		testUnit{"Italy", Army, "Tri"},
+		testUnit{"Italy", Army, "Ven"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("Austria", "Vie", "Tri"))
+	assert.NoError(t, s.AddSupportOrder("Austria", "Bud", "Vie", "Tri"))
+	assert.NoError(t, s.AddSupportOrder("Italy", "Ven", "Tri", "Tri"))
+
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Vie", "Austria", Army)
+	assertUnitAt(t, s, "Tri", "Italy", Army)
+}
+
+func TestAdjudicate_CannotDislodgeOwnUnit(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Germany", Army, "Mun"},
+		testUnit{"Germany", Army, "Ber"},
+		testUnit{"Germany", Army, "Sil"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("Germany", "Ber", "Sil"))
+	assert.NoError(t, s.AddSupportOrder("Germany", "Mun", "Ber", "Sil"))
+
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Ber", "Germany", Army)
+	assertUnitAt(t, s, "Sil", "Germany", Army)
+}
+
+func TestAdjudicate_SupportFromDefenderDoesNotDislodge(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Germany", Army, "Mun"},
+		testUnit{"Austria", Army, "Boh"},
+		testUnit{"Austria", Army, "Tyr"},
+	)
+
+	// Austria supports an attack against its own unit
+	assert.NoError(t, s.AddMoveOrder("Germany", "Mun", "Tyr"))
+	assert.NoError(t, s.AddSupportOrder("Austria", "Boh", "Mun", "Tyr"))
+
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Mun", "Germany", Army)
+	assertUnitAt(t, s, "Tyr", "Austria", Army)
+}
+
+func TestAdjudicate_CircularMovement(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Germany", Army, "Mun"},
+		testUnit{"Austria", Army, "Boh"},
+		testUnit{"Austria", Army, "Tyr"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("Germany", "Mun", "Boh"))
+	assert.NoError(t, s.AddMoveOrder("Austria", "Boh", "Tyr"))
+	assert.NoError(t, s.AddMoveOrder("Austria", "Tyr", "Mun"))
+
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Boh", "Germany", Army)
+	assertUnitAt(t, s, "Tyr", "Austria", Army)
+	assertUnitAt(t, s, "Mun", "Austria", Army)
+}
+
+func TestAdjudicate_BrokenCircularMovement(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Germany", Army, "Mun"},
+		testUnit{"Austria", Army, "Boh"},
+		testUnit{"Austria", Army, "Tyr"},
+		testUnit{"Italy", Army, "Ven"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("Germany", "Mun", "Boh"))
+	assert.NoError(t, s.AddMoveOrder("Austria", "Boh", "Tyr"))
+	assert.NoError(t, s.AddMoveOrder("Austria", "Tyr", "Mun"))
+	assert.NoError(t, s.AddMoveOrder("Italy", "Ven", "Tyr"))
+
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Mun", "Germany", Army)
+	assertUnitAt(t, s, "Boh", "Austria", Army)
+	assertUnitAt(t, s, "Tyr", "Austria", Army)
+	assertUnitAt(t, s, "Ven", "Italy", Army)
+}
+
+func TestAdjudicate_ClearsOrders(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"Germany", Army, "Mun"})
+
+	assert.NoError(t, s.AddMoveOrder("Germany", "Mun", "Boh"))
+	adjudicate(t, s)
+
+	germany, _ := s.GetCountry("Germany")
+	assert.Empty(t, germany.orders)
+	assertUnitAt(t, s, "Boh", "Germany", Army)
+	assert.Nil(t, s.World.Provinces["Boh"].Unit.Order)
+}
+
+func TestAdjudicate_SupportCutByAttack(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Austria", Army, "Vie"},
+		testUnit{"Austria", Army, "Bud"},
+		testUnit{"Italy", Army, "Tri"},
+		testUnit{"Russia", Army, "Gal"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("Austria", "Vie", "Tri"))
+	assert.NoError(t, s.AddSupportOrder("Austria", "Bud", "Vie", "Tri"))
+	// the attack on Budapest bounces but still cuts the support
+	assert.NoError(t, s.AddMoveOrder("Russia", "Gal", "Bud"))
+
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Vie", "Austria", Army)
+	assertUnitAt(t, s, "Bud", "Austria", Army)
+	assertUnitAt(t, s, "Tri", "Italy", Army)
+	assertUnitAt(t, s, "Gal", "Russia", Army)
+}
+
+func TestAdjudicate_SupportNotCutByOwnCountry(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Austria", Army, "Vie"},
+		testUnit{"Austria", Army, "Bud"},
+		testUnit{"Austria", Army, "Gal"},
+		testUnit{"Italy", Army, "Tri"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("Austria", "Vie", "Tri"))
+	assert.NoError(t, s.AddSupportOrder("Austria", "Bud", "Vie", "Tri"))
+	assert.NoError(t, s.AddMoveOrder("Austria", "Gal", "Bud"))
+
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Tri", "Austria", Army)
+	assertUnitAt(t, s, "Bud", "Austria", Army)
+}
+
+func TestAdjudicate_SupportNotCutByAttackFromTarget(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Germany", Army, "Mun"},
+		testUnit{"Germany", Army, "Sil"},
+		testUnit{"Austria", Army, "Boh"},
+	)
+
+	// Bohemia attacks the supporting unit, but is itself the target
+	assert.NoError(t, s.AddMoveOrder("Germany", "Mun", "Boh"))
+	assert.NoError(t, s.AddSupportOrder("Germany", "Sil", "Mun", "Boh"))
+	assert.NoError(t, s.AddMoveOrder("Austria", "Boh", "Sil"))
+
+	adjudicate(t, s)
+
+	assertEmpty(t, s, "Mun")
+	assertUnitAt(t, s, "Boh", "Germany", Army)
+	assertUnitAt(t, s, "Sil", "Germany", Army)
+}
+
+func TestAdjudicate_SupportCutByDislodgementFromTarget(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Germany", Army, "Mun"},
+		testUnit{"Germany", Army, "Sil"},
+		testUnit{"Austria", Army, "Boh"},
+		testUnit{"Austria", Army, "Gal"},
+		testUnit{"Austria", Army, "Tyr"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("Germany", "Mun", "Boh"))
+	assert.NoError(t, s.AddSupportOrder("Germany", "Sil", "Mun", "Boh"))
+	assert.NoError(t, s.AddMoveOrder("Austria", "Boh", "Sil"))
+	assert.NoError(t, s.AddSupportOrder("Austria", "Gal", "Boh", "Sil"))
+	assert.NoError(t, s.AddMoveOrder("Austria", "Tyr", "Boh"))
+
+	adjudicate(t, s)
+
+	// the dislodged support no longer helps Munich, which bounces with Tyrolia
+	assertUnitAt(t, s, "Mun", "Germany", Army)
+	assertUnitAt(t, s, "Tyr", "Austria", Army)
+	assertEmpty(t, s, "Boh")
+	assertUnitAt(t, s, "Sil", "Austria", Army)
+}
+
+func TestAdjudicate_ConvoyAcrossOneSea(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"England", Army, "Lon"},
+		testUnit{"England", Fleet, "NTH"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("England", "Lon", "Nwy"))
+	assert.NoError(t, s.AddConvoyOrder("England", "NTH", "Lon", "Nwy"))
+
+	adjudicate(t, s)
+
+	assertEmpty(t, s, "Lon")
+	assertUnitAt(t, s, "Nwy", "England", Army)
+	assertUnitAt(t, s, "NTH", "England", Fleet)
+}
+
+func TestAdjudicate_ConvoyOverChainOfFleets(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"England", Army, "Lon"},
+		testUnit{"England", Fleet, "ENG"},
+		testUnit{"France", Fleet, "MAO"},
+		testUnit{"Italy", Fleet, "WES"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("England", "Lon", "Tun"))
+	assert.NoError(t, s.AddConvoyOrder("England", "ENG", "Lon", "Tun"))
+	assert.NoError(t, s.AddConvoyOrder("France", "MAO", "Lon", "Tun"))
+	assert.NoError(t, s.AddConvoyOrder("Italy", "WES", "Lon", "Tun"))
+
+	adjudicate(t, s)
+
+	assertEmpty(t, s, "Lon")
+	assertUnitAt(t, s, "Tun", "England", Army)
+}
+
+func TestAdjudicate_BrokenChainOfFleets(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"England", Army, "Lon"},
+		testUnit{"England", Fleet, "ENG"},
+		testUnit{"Italy", Fleet, "WES"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("England", "Lon", "Tun"))
+	assert.NoError(t, s.AddConvoyOrder("England", "ENG", "Lon", "Tun"))
+	assert.NoError(t, s.AddConvoyOrder("Italy", "WES", "Lon", "Tun"))
+
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Lon", "England", Army)
+	assertEmpty(t, s, "Tun")
+}
+
+func TestAdjudicate_MoveToDistantProvinceWithoutConvoyFails(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"England", Army, "Lon"})
+
+	assert.NoError(t, s.AddMoveOrder("England", "Lon", "Nwy"))
+
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Lon", "England", Army)
+	assertEmpty(t, s, "Nwy")
+}
+
+func TestAdjudicate_ConvoyDisruptedByDislodgement(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"England", Army, "Lon"},
+		testUnit{"England", Fleet, "NTH"},
+		testUnit{"Germany", Fleet, "HEL"},
+		testUnit{"Germany", Fleet, "Den"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("England", "Lon", "Nwy"))
+	assert.NoError(t, s.AddConvoyOrder("England", "NTH", "Lon", "Nwy"))
+	assert.NoError(t, s.AddMoveOrder("Germany", "HEL", "NTH"))
+	assert.NoError(t, s.AddSupportOrder("Germany", "Den", "HEL", "NTH"))
+
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Lon", "England", Army)
+	assertEmpty(t, s, "Nwy")
+	assertUnitAt(t, s, "NTH", "Germany", Fleet)
+}
+
+func TestAdjudicate_SwapByConvoy(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"England", Army, "Lon"},
+		testUnit{"England", Fleet, "NTH"},
+		testUnit{"France", Army, "Bel"},
+		testUnit{"France", Fleet, "ENG"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("England", "Lon", "Bel"))
+	assert.NoError(t, s.AddConvoyOrder("England", "NTH", "Lon", "Bel"))
+	assert.NoError(t, s.AddMoveOrder("France", "Bel", "Lon"))
+	assert.NoError(t, s.AddConvoyOrder("France", "ENG", "Bel", "Lon"))
+
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Bel", "England", Army)
+	assertUnitAt(t, s, "Lon", "France", Army)
+}
+
+func TestAdjudicate_OwnConvoyToAdjacentProvinceAvoidsHeadToHead(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"England", Army, "Nwy"},
+		testUnit{"England", Fleet, "SKA"},
+		testUnit{"Russia", Army, "Swe"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("England", "Nwy", "Swe"))
+	assert.NoError(t, s.AddConvoyOrder("England", "SKA", "Nwy", "Swe"))
+	assert.NoError(t, s.AddMoveOrder("Russia", "Swe", "Nwy"))
+
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Swe", "England", Army)
+	assertUnitAt(t, s, "Nwy", "Russia", Army)
+}
+
+func TestAdjudicate_ConvoyedMoveViaConvoyWithoutFleetFails(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"England", Army, "Nwy"},
+	)
+
+	assert.NoError(t, s.AddConvoyedMoveOrder("England", "Nwy", "Swe"))
+
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Nwy", "England", Army)
+	assertEmpty(t, s, "Swe")
+}
+
+func TestAdjudicate_DisruptedConvoyDoesNotCutSupport(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"England", Army, "Lon"},
+		testUnit{"England", Fleet, "NTH"},
+		testUnit{"England", Fleet, "SKA"},
+		testUnit{"Germany", Fleet, "HEL"},
+		testUnit{"Germany", Fleet, "Den"},
+		testUnit{"Russia", Fleet, "Nwy"},
+		testUnit{"Russia", Fleet, "Swe"},
+	)
+
+	// the Russian support from Norway is only cut if the English army arrives
+	assert.NoError(t, s.AddMoveOrder("England", "Lon", "Nwy"))
+	assert.NoError(t, s.AddConvoyOrder("England", "NTH", "Lon", "Nwy"))
+	assert.NoError(t, s.AddMoveOrder("Germany", "HEL", "NTH"))
+	assert.NoError(t, s.AddSupportOrder("Germany", "Den", "HEL", "NTH"))
+	assert.NoError(t, s.AddMoveOrder("Russia", "Swe", "SKA"))
+	assert.NoError(t, s.AddSupportOrder("Russia", "Nwy", "Swe", "SKA"))
+
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Lon", "England", Army)
+	assertUnitAt(t, s, "NTH", "Germany", Fleet)
+	assertUnitAt(t, s, "SKA", "Russia", Fleet)
+}
+
+func TestAdjudicate_SimpleConvoyParadox(t *testing.T) {
+	tests := []struct {
+		name          string
+		rule          ParadoxRule
+		expectInENG   string
+		expectInWales bool
+	}{
+		{"Szykman", SzykmanRule, "England", false},
+		{"All hold", AllHoldRule, "France", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := setupAdjudicationState(
+				testUnit{"England", Fleet, "Lon"},
+				testUnit{"England", Fleet, "Wal"},
+				testUnit{"France", Army, "Bre"},
+				testUnit{"France", Fleet, "ENG"},
+			)
+			s.ParadoxRule = test.rule
+
+			assert.NoError(t, s.AddSupportOrder("England", "Lon", "Wal", "ENG"))
+			assert.NoError(t, s.AddMoveOrder("England", "Wal", "ENG"))
+			assert.NoError(t, s.AddMoveOrder("France", "Bre", "Lon"))
+			assert.NoError(t, s.AddConvoyOrder("France", "ENG", "Bre", "Lon"))
+
+			adjudicate(t, s)
+
+			assertUnitAt(t, s, "Lon", "England", Fleet)
+			assertUnitAt(t, s, "Bre", "France", Army)
+			assertUnitAt(t, s, "ENG", test.expectInENG, Fleet)
+			if test.expectInWales {
+				assertUnitAt(t, s, "Wal", "England", Fleet)
+			} else {
+				assertEmpty(t, s, "Wal")
+			}
+		})
+	}
+}
+
+func TestAdjudicate_IllegalMovesHold(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"France", Fleet, "Bre"},
+		testUnit{"France", Army, "Gas"},
+		testUnit{"France", Army, "Mar"},
+		testUnit{"Germany", Army, "Bur"},
+	)
+
+	w := s.World.Provinces
+	addUncheckedOrder(s, "France", &MoveOrder{Position: w["Bre"], Destination: w["Par"]})
+	addUncheckedOrder(s, "France", &MoveOrder{Position: w["Gas"], Destination: w["MAO"]})
+	addUncheckedOrder(s, "France", &MoveOrder{Position: w["Mar"], Destination: w["Mar"]})
+	// the illegal move is treated as hold and can be supported
+	assert.NoError(t, s.AddMoveOrder("Germany", "Bur", "Mar"))
+	assert.NoError(t, s.AddSupportOrder("France", "Gas", "Mar", "Mar"))
+
+	adjudicate(t, s)
+
+	assertEmpty(t, s, "Par")
+	assertEmpty(t, s, "MAO")
+	assertUnitAt(t, s, "Bre", "France", Fleet)
+	assertUnitAt(t, s, "Mar", "France", Army)
+	assertUnitAt(t, s, "Bur", "Germany", Army)
+}
+
+func TestAdjudicate_ConvoyedFleetHolds(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"England", Fleet, "Lon"})
+
+	w := s.World.Provinces
+	addUncheckedOrder(s, "England", &MoveOrder{Position: w["Lon"], Destination: w["ENG"], ViaConvoy: true})
+	adjudicate(t, s)
+
+	assertEmpty(t, s, "ENG")
+	assertUnitAt(t, s, "Lon", "England", Fleet)
+}
+
+func TestAdjudicate_SupportRequiresReachingDestination(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Germany", Army, "Ruh"},
+		testUnit{"Germany", Fleet, "Hol"},
+		testUnit{"France", Army, "Bur"},
+		testUnit{"France", Army, "Bel"},
+	)
+
+	// the fleet in Holland cannot reach inland Ruhr and cannot support there
+	assert.NoError(t, s.AddMoveOrder("France", "Bel", "Ruh"))
+	assert.NoError(t, s.AddSupportOrder("France", "Bur", "Bel", "Ruh"))
+	w := s.World.Provinces
+	addUncheckedOrder(s, "Germany", &SupportOrder{Position: w["Hol"], Source: w["Ruh"], Destination: w["Ruh"]})
+
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Ruh", "France", Army)
+	assert.Len(t, s.Dislodged, 1)
+}
+
+func TestAdjudicate_MoveToCoast(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"France", Fleet, "MAO"},
+		testUnit{"France", Fleet, "Gas"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("France", "MAO", "Spa/sc"))
+	assert.NoError(t, s.AddMoveOrder("France", "Gas", "Bre"))
+
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Spa", "France", Fleet)
+	assert.Equal(t, SouthCoast, s.World.Provinces["Spa"].Unit.Coast)
+}
+
+func TestAdjudicate_MoveToUnreachableCoastHolds(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"France", Fleet, "Gas"})
+
+	w := s.World.Provinces
+	addUncheckedOrder(s, "France", &MoveOrder{Position: w["Gas"], Destination: w["Spa"], Coast: SouthCoast})
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Gas", "France", Fleet)
+	assertEmpty(t, s, "Spa")
+}
+
+func TestAdjudicate_MoveWithoutCoast(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"France", Fleet, "Gas"},
+		testUnit{"France", Fleet, "Por"},
+	)
+
+	// Gascony only borders the north coast, Portugal borders both
+	assert.NoError(t, s.AddMoveOrder("France", "Gas", "Spa"))
+	assert.ErrorIs(t, s.AddMoveOrder("France", "Por", "Spa"), ErrCoastRequired)
+	assert.Equal(t, NorthCoast, s.World.Provinces["Gas"].Unit.Order.(*MoveOrder).Coast)
+	w := s.World.Provinces
+	addUncheckedOrder(s, "France", &MoveOrder{Position: w["Por"], Destination: w["Spa"]})
+
+	adjudicate(t, s)
+
+	assertEmpty(t, s, "Gas")
+	assertUnitAt(t, s, "Por", "France", Fleet)
+	assert.Equal(t, NorthCoast, s.World.Provinces["Spa"].Unit.Coast)
+}
+
+func TestAdjudicate_SupportFromCoastRequiresReachingDestination(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"France", Fleet, "Spa/nc"},
+		testUnit{"France", Army, "Bur"},
+		testUnit{"Italy", Army, "Mar"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("France", "Bur", "Mar"))
+	w := s.World.Provinces
+	addUncheckedOrder(s, "France", &SupportOrder{Position: w["Spa"], Source: w["Bur"], Destination: w["Mar"]})
+
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Mar", "Italy", Army)
+	assertUnitAt(t, s, "Bur", "France", Army)
+}
+
+func TestAdjudicate_CoastOccupiesProvince(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Russia", Fleet, "Stp/sc"},
+		testUnit{"Russia", Army, "Mos"},
+	)
+
+	// the fleet on the south coast blocks the whole province
+	assert.NoError(t, s.AddMoveOrder("Russia", "Mos", "Stp"))
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Stp", "Russia", Fleet)
+	assertUnitAt(t, s, "Mos", "Russia", Army)
+}
+
+func TestAdjudicate_StrengthCountsResolvedSupports(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"France", Army, "Bur"},
+		testUnit{"France", Army, "Gas"},
+		testUnit{"Germany", Army, "Mar"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("France", "Bur", "Mar"))
+	assert.NoError(t, s.AddSupportOrder("France", "Gas", "Bur", "Mar"))
+	// the support is counted from the orders given, not from the unit
+	s.World.Provinces["Gas"].Unit.Order = nil
+
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Mar", "France", Army)
+	assert.Len(t, s.Dislodged, 1)
+}
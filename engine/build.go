@@ -0,0 +1,356 @@
+package engine
+
+import (
+	"fmt"
+	"sort"
+)
+
+type BuildOrder struct {
+	Country  *Country
+	Type     UnitType
+	Position *Province
+	Coast    Coast
+}
+
+func (b BuildOrder) String() string {
+	return fmt.Sprintf("%s %s B", b.Type, location(b.Position.Key, b.Coast))
+}
+
+func (b BuildOrder) GetPosition() *Province {
+	return b.Position
+}
+
+func (b BuildOrder) GetSource() *Province {
+	return b.Position
+}
+
+func (b BuildOrder) GetDestination() *Province {
+	return b.Position
+}
+
+// Adjustment returns the number of units a country may build (positive) or
+// has to disband (negative) in the winter, which is the number of supply
+// centers it owns minus the number of its units.
+func (s *State) Adjustment(country string) (int, error) {
+	c, err := s.GetCountry(country)
+	if err != nil {
+		return 0, err
+	}
+	return s.adjustment(c), nil
+}
+
+func (s *State) adjustment(c *Country) int {
+	return s.centers(c) - len(s.World.GetUnits(c.Name))
+}
+
+// centers counts the supply centers owned by the country.
+func (s *State) centers(c *Country) int {
+	centers := 0
+	for _, p := range s.World.Provinces {
+		if p.IsSupplyCenter && p.OwnedBy == c {
+			centers++
+		}
+	}
+	return centers
+}
+
+func (s *State) AddBuildOrder(country, position string, unitType UnitType) error {
+	c, err := s.GetCountry(country)
+	if err != nil {
+		return err
+	}
+
+	err = s.requirePhase(BuildPhase)
+	if err != nil {
+		return err
+	}
+
+	pos, coast, err := s.World.GetLocation(position)
+	if err != nil {
+		return err
+	}
+	if unitType != Fleet {
+		coast = NoCoast
+	}
+
+	err = canBuild(c, pos, coast, unitType)
+	if err != nil {
+		return err
+	}
+
+	builds := 0
+	for _, order := range c.orders {
+		if _, ok := order.(*BuildOrder); ok && order.GetPosition() != pos {
+			builds++
+		}
+	}
+	if builds >= s.adjustment(c) {
+		return &AdjustmentLimitError{Country: c.Name, Limit: max(s.adjustment(c), 0), Build: true}
+	}
+
+	return s.addAdjustmentOrder(c, &BuildOrder{Country: c, Type: unitType, Position: pos, Coast: coast})
+}
+
+func (s *State) addUnitDisbandOrder(country *Country, position string) error {
+	pos, _, err := s.World.GetLocation(position)
+	if err != nil {
+		return err
+	}
+
+	if pos.Unit == nil {
+		return &NoUnitError{Key: pos.Key}
+	}
+	if pos.Unit.Country != country {
+		return &NotYourUnitError{Country: country.Name, Owner: pos.Unit.Country.Name}
+	}
+
+	disbands := 0
+	for _, order := range country.orders {
+		if _, ok := order.(*DisbandOrder); ok && order.GetPosition() != pos {
+			disbands++
+		}
+	}
+	if disbands >= -s.adjustment(country) {
+		return &AdjustmentLimitError{Country: country.Name, Limit: max(-s.adjustment(country), 0)}
+	}
+
+	return s.addAdjustmentOrder(country, &DisbandOrder{Unit: pos.Unit, Position: pos})
+}
+
+func (s *State) addAdjustmentOrder(country *Country, newOrder Order) error {
+	if country.Ready {
+		return &OrdersLockedError{Country: country.Name}
+	}
+
+	for index, existing := range country.orders {
+		if newOrder.GetPosition().Key == existing.GetPosition().Key {
+			country.orders[index] = newOrder
+			return nil
+		}
+	}
+
+	country.orders = append(country.orders, newOrder)
+
+	return nil
+}
+
+// canBuild checks that a unit may be built in a province: it has to be an
+// unoccupied home center owned by the country, and fleets have to be built on
+// the coast, on one of its coasts if it has several.
+func canBuild(country *Country, province *Province, coast Coast, unitType UnitType) error {
+	reject := func(reason error) error {
+		return &IllegalBuildError{Country: country.Name, Key: province.Key, Reason: reason}
+	}
+
+	if !isHomeCenter(country, province) {
+		return reject(ErrNotHomeCenter)
+	}
+	if province.OwnedBy != country {
+		return reject(ErrNotOwned)
+	}
+	if province.Unit != nil {
+		return reject(ErrProvinceOccupied)
+	}
+	if unitType == Fleet && !isCoastal(province) {
+		return reject(ErrNotCoastal)
+	}
+	if unitType == Fleet && len(province.Coasts) > 0 && coast == NoCoast {
+		return reject(ErrCoastRequired)
+	}
+	return nil
+}
+
+func isHomeCenter(country *Country, province *Province) bool {
+	for _, hc := range country.HomeCenters {
+		if hc == province.Key {
+			return true
+		}
+	}
+	return false
+}
+
+func isCoastal(province *Province) bool {
+	if province.Type != LandTile {
+		return false
+	}
+	for _, edge := range province.Edges {
+		if edge.Province.Type == WaterTile {
+			return true
+		}
+	}
+	return false
+}
+
+// updateSupplyCenters hands every occupied supply center to the country of
+// the occupying unit. It runs once the retreats of the Fall are done.
+func (s *State) updateSupplyCenters() {
+	for _, key := range sortedProvinceKeys(s.World) {
+		p := s.World.Provinces[key]
+		if p.IsSupplyCenter && p.Unit != nil && p.OwnedBy != p.Unit.Country {
+			s.logger().Info("Supply center taken", "country", p.Unit.Country.Name, "province", p.Key)
+			p.OwnedBy = p.Unit.Country
+		}
+	}
+}
+
+// SetOwner hands a supply center to a country, e.g. to set up a position.
+func (s *State) SetOwner(position, country string) error {
+	c, err := s.GetCountry(country)
+	if err != nil {
+		return err
+	}
+	p, err := s.World.GetProvince(position)
+	if err != nil {
+		return err
+	}
+	if !p.IsSupplyCenter {
+		return &NotSupplyCenterError{Key: p.Key}
+	}
+
+	p.OwnedBy = c
+	return nil
+}
+
+// SupplyCenters lists the keys of the supply centers owned by the country.
+func (s *State) SupplyCenters(country string) ([]string, error) {
+	c, err := s.GetCountry(country)
+	if err != nil {
+		return nil, err
+	}
+
+	centers := []string{}
+	for _, key := range sortedProvinceKeys(s.World) {
+		p := s.World.Provinces[key]
+		if p.IsSupplyCenter && p.OwnedBy == c {
+			centers = append(centers, key)
+		}
+	}
+	return centers, nil
+}
+
+// CenterCounts returns the number of supply centers of every country,
+// including the ones without any.
+func (s *State) CenterCounts() map[string]int {
+	counts := map[string]int{}
+	for _, c := range s.Countries {
+		if c != nil {
+			counts[c.Name] = s.centers(c)
+		}
+	}
+	return counts
+}
+
+// adjudicateAdjustments builds and disbands units in the winter. Builds
+// exceeding the allowed number are ignored. Countries that do not order
+// enough disbands are in civil disorder and lose their units farthest from
+// home.
+func (s *State) adjudicateAdjustments() ([]*OrderResult, error) {
+	results := []*OrderResult{}
+	for _, c := range s.Countries {
+		if c == nil {
+			continue
+		}
+
+		adjustment := s.adjustment(c)
+		for _, order := range c.orders {
+			result := newOrderResult(order, c.Name)
+			results = append(results, result)
+
+			switch o := order.(type) {
+			case *BuildOrder:
+				if adjustment <= 0 {
+					result.Status, result.Reason = Void, "No builds left"
+					continue
+				}
+				if err := canBuild(c, o.Position, o.Coast, o.Type); err != nil {
+					result.Status, result.Reason = Void, err.Error()
+					continue
+				}
+				s.logger().Info("Unit built", "order", o)
+				_, err := s.World.AddUnit(c, o.Type, location(o.Position.Key, o.Coast))
+				if err != nil {
+					return nil, err
+				}
+				adjustment--
+			case *DisbandOrder:
+				if adjustment >= 0 || o.Position.Unit != o.Unit || o.Unit.Country != c {
+					result.Status, result.Reason = Void, "No disbands required"
+					continue
+				}
+				s.logger().Info("Unit disbanded", "order", o)
+				o.Position.Unit = nil
+				adjustment++
+			}
+		}
+
+		for _, p := range s.civilDisorder(c, -adjustment) {
+			s.logger().Info("Unit disbanded in civil disorder", "country", c.Name, "province", p.Key)
+			result := newOrderResult(&DisbandOrder{Unit: p.Unit, Position: p}, c.Name)
+			result.Reason = "Civil disorder"
+			results = append(results, result)
+			p.Unit = nil
+		}
+	}
+
+	return results, nil
+}
+
+// civilDisorder picks the units to disband for a country that did not order
+// enough disbands. Units farthest from the home centers go first, fleets
+// before armies at equal distance, and then alphabetically by province name.
+func (s *State) civilDisorder(country *Country, count int) []*Province {
+	if count <= 0 {
+		return nil
+	}
+
+	positions := []*Province{}
+	distances := map[*Province]int{}
+	for _, p := range s.World.Provinces {
+		if p.Unit != nil && p.Unit.Country == country {
+			positions = append(positions, p)
+			distances[p] = s.distanceFromHome(country, p)
+		}
+	}
+
+	sort.Slice(positions, func(i, j int) bool {
+		a, b := positions[i], positions[j]
+		if distances[a] != distances[b] {
+			return distances[a] > distances[b]
+		}
+		if a.Unit.Type != b.Unit.Type {
+			return a.Unit.Type == Fleet
+		}
+		return a.Name < b.Name
+	})
+
+	if count > len(positions) {
+		count = len(positions)
+	}
+	return positions[:count]
+}
+
+// distanceFromHome counts the moves needed to get from a province to the
+// nearest home center of the country.
+func (s *State) distanceFromHome(country *Country, from *Province) int {
+	distance := map[*Province]int{from: 0}
+	queue := []*Province{from}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if isHomeCenter(country, current) {
+			return distance[current]
+		}
+
+		for _, key := range sortedEdgeKeys(current) {
+			next := current.Edges[key].Province
+			if _, seen := distance[next]; !seen {
+				distance[next] = distance[current] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	return len(s.World.Provinces)
+}
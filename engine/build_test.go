@@ -0,0 +1,264 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// setupBuildState prepares a winter build phase with the standard home
+// centers and the given supply center owners.
+func setupBuildState(owners map[string]string, units ...testUnit) *State {
+	s := setupAdjudicationState(units...)
+	s.Turn = Winter
+	s.Phase = BuildPhase
+
+	standard, _ := InitializeNewGame(StandardVariant)
+	for i, c := range s.Countries {
+		c.HomeCenters = standard.Countries[i].HomeCenters
+	}
+	for key, owner := range owners {
+		s.SetOwner(key, owner)
+	}
+
+	return s
+}
+
+func TestAdjudicate_SupplyCentersChangeOwnerAfterFall(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Austria", Army, "Vie"},
+		testUnit{"Austria", Army, "Gal"},
+	)
+	s.Turn = Fall
+	assert.NoError(t, s.SetOwner("Vie", "Austria"))
+	assert.NoError(t, s.SetOwner("War", "Russia"))
+
+	assert.NoError(t, s.AddMoveOrder("Austria", "Gal", "War"))
+	adjudicate(t, s)
+
+	assert.Equal(t, Winter, s.Turn)
+	assert.Equal(t, BuildPhase, s.Phase)
+	assert.Equal(t, "Austria", s.World.Provinces["War"].OwnedBy.Name)
+	assert.Equal(t, "Austria", s.World.Provinces["Vie"].OwnedBy.Name)
+}
+
+func TestAdjudicate_SupplyCentersKeepOwnerAfterSpring(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"Austria", Army, "Gal"})
+	assert.NoError(t, s.SetOwner("War", "Russia"))
+
+	assert.NoError(t, s.AddMoveOrder("Austria", "Gal", "War"))
+	adjudicate(t, s)
+
+	assert.Equal(t, Fall, s.Turn)
+	assert.Equal(t, "Russia", s.World.Provinces["War"].OwnedBy.Name)
+}
+
+func TestAdjustment(t *testing.T) {
+	s := setupBuildState(
+		map[string]string{"Vie": "Austria", "Bud": "Austria", "Tri": "Austria", "Ser": "Austria", "War": "Russia"},
+		testUnit{"Austria", Army, "Vie"},
+		testUnit{"Russia", Army, "Mos"},
+		testUnit{"Russia", Army, "Sev"},
+	)
+
+	austria, err := s.Adjustment("Austria")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, austria)
+
+	russia, err := s.Adjustment("Russia")
+	assert.NoError(t, err)
+	assert.Equal(t, -1, russia)
+
+	_, err = s.Adjustment("Spain")
+	assert.Error(t, err)
+}
+
+func TestAddBuildOrder_InvalidBuilds(t *testing.T) {
+	tests := []struct {
+		name     string
+		country  string
+		position string
+		unitType UnitType
+		expected error
+	}{
+		{"Not a home center", "Austria", "Ser", Army, ErrNotHomeCenter},
+		{"Home center of another country", "Austria", "Ven", Army, ErrNotHomeCenter},
+		{"Home center not owned", "Austria", "Bud", Army, ErrNotOwned},
+		{"Occupied", "Austria", "Vie", Army, ErrProvinceOccupied},
+		{"Fleet inland", "Russia", "Mos", Fleet, ErrNotCoastal},
+		{"Fleet without coast", "Russia", "Stp", Fleet, ErrCoastRequired},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := setupBuildState(
+				map[string]string{"Vie": "Austria", "Tri": "Austria", "Ser": "Austria", "Bud": "Russia", "Mos": "Russia", "Sev": "Russia", "Stp": "Russia"},
+				testUnit{"Austria", Army, "Vie"},
+			)
+			err := s.AddBuildOrder(test.country, test.position, test.unitType)
+			assert.ErrorIs(t, err, test.expected)
+
+			var buildErr *IllegalBuildError
+			if assert.ErrorAs(t, err, &buildErr) {
+				assert.Equal(t, test.position, buildErr.Key)
+			}
+		})
+	}
+}
+
+func TestAddBuildOrder_TooManyBuilds(t *testing.T) {
+	s := setupBuildState(
+		map[string]string{"Vie": "Austria", "Bud": "Austria", "Tri": "Austria"},
+		testUnit{"Austria", Army, "Ser"},
+		testUnit{"Austria", Army, "Gal"},
+	)
+
+	assert.NoError(t, s.AddBuildOrder("Austria", "Tri", Fleet))
+	// replacing the build in the same province is allowed
+	assert.NoError(t, s.AddBuildOrder("Austria", "Tri", Army))
+	assert.EqualError(t, s.AddBuildOrder("Austria", "Vie", Army), "Austria cannot build more than 1 units")
+}
+
+func TestAdjudicate_Builds(t *testing.T) {
+	s := setupBuildState(
+		map[string]string{"Vie": "Austria", "Bud": "Austria", "Tri": "Austria", "Ser": "Austria"},
+		testUnit{"Austria", Army, "Ser"},
+	)
+
+	assert.NoError(t, s.AddBuildOrder("Austria", "Tri", Fleet))
+	assert.NoError(t, s.AddBuildOrder("Austria", "Vie", Army))
+	adjudicate(t, s)
+
+	assert.Equal(t, Spring, s.Turn)
+	assert.Equal(t, OrderPhase, s.Phase)
+	assertUnitAt(t, s, "Tri", "Austria", Fleet)
+	assertUnitAt(t, s, "Vie", "Austria", Army)
+	assertEmpty(t, s, "Bud")
+	austria, _ := s.GetCountry("Austria")
+	assert.Empty(t, austria.orders)
+}
+
+func TestAdjudicate_BuildOnCoast(t *testing.T) {
+	s := setupBuildState(map[string]string{"Stp": "Russia"})
+
+	assert.NoError(t, s.AddBuildOrder("Russia", "Stp/nc", Fleet))
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Stp", "Russia", Fleet)
+	assert.Equal(t, NorthCoast, s.World.Provinces["Stp"].Unit.Coast)
+}
+
+func TestAdjudicate_Disbands(t *testing.T) {
+	s := setupBuildState(
+		map[string]string{"Vie": "Austria"},
+		testUnit{"Austria", Army, "Vie"},
+		testUnit{"Austria", Army, "Ser"},
+		testUnit{"Austria", Fleet, "Tri"},
+	)
+
+	assert.NoError(t, s.AddDisbandOrder("Austria", "Vie"))
+	assert.NoError(t, s.AddDisbandOrder("Austria", "Tri"))
+	assert.EqualError(t, s.AddDisbandOrder("Austria", "Ser"), "Austria cannot disband more than 2 units")
+	adjudicate(t, s)
+
+	assertEmpty(t, s, "Vie")
+	assertEmpty(t, s, "Tri")
+	assertUnitAt(t, s, "Ser", "Austria", Army)
+}
+
+func TestAddDisbandOrder_InBuildPhase(t *testing.T) {
+	s := setupBuildState(map[string]string{}, testUnit{"Austria", Army, "Vie"}, testUnit{"Italy", Army, "Ven"})
+
+	assert.ErrorIs(t, s.AddDisbandOrder("Austria", "Bud"), ErrNoUnit)
+	assert.Error(t, s.AddDisbandOrder("Austria", "Ven"))
+	assert.NoError(t, s.AddDisbandOrder("Austria", "Vie"))
+}
+
+func TestAdjudicate_CivilDisorder(t *testing.T) {
+	s := setupBuildState(
+		map[string]string{"Vie": "Austria", "Bud": "Austria"},
+		testUnit{"Austria", Army, "Vie"},
+		testUnit{"Austria", Army, "Gre"},
+		testUnit{"Austria", Fleet, "ION"},
+		testUnit{"Austria", Army, "Alb"},
+		testUnit{"Austria", Army, "Gal"},
+	)
+
+	// Greece and the Ionian Sea are both two moves away from Trieste, the
+	// fleet is disbanded first
+	assert.NoError(t, s.AddDisbandOrder("Austria", "Gal"))
+	adjudicate(t, s)
+
+	assert.Len(t, s.World.GetUnits("Austria"), 2)
+	assertEmpty(t, s, "Gal")
+	assertEmpty(t, s, "ION")
+	assertEmpty(t, s, "Gre")
+	assertUnitAt(t, s, "Vie", "Austria", Army)
+	assertUnitAt(t, s, "Alb", "Austria", Army)
+}
+
+func TestCivilDisorder_OrdersByDistanceTypeAndName(t *testing.T) {
+	s := setupBuildState(
+		map[string]string{},
+		testUnit{"Austria", Army, "Alb"},
+		testUnit{"Austria", Army, "Gre"},
+		testUnit{"Austria", Army, "Bul"},
+		testUnit{"Austria", Fleet, "ION"},
+		testUnit{"Austria", Army, "Vie"},
+	)
+	austria, _ := s.GetCountry("Austria")
+
+	assert.Equal(t, []string{"ION", "Bul", "Gre", "Alb", "Vie"}, provinceKeys(s.civilDisorder(austria, 5)))
+	assert.Equal(t, []string{"ION"}, provinceKeys(s.civilDisorder(austria, 1)))
+	assert.Empty(t, s.civilDisorder(austria, 0))
+}
+
+func TestAdjudicate_SupplyCentersChangeOwnerAfterFallRetreats(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Austria", Army, "Gal"},
+		testUnit{"Austria", Army, "Sil"},
+		testUnit{"Russia", Army, "War"},
+	)
+	s.Turn = Fall
+	assert.NoError(t, s.SetOwner("War", "Russia"))
+
+	assert.NoError(t, s.AddMoveOrder("Austria", "Gal", "War"))
+	assert.NoError(t, s.AddSupportOrder("Austria", "Sil", "Gal", "War"))
+	adjudicate(t, s)
+
+	assert.Equal(t, "F1901R", s.PhaseName())
+	assert.Equal(t, "Russia", s.World.Provinces["War"].OwnedBy.Name)
+
+	assert.NoError(t, s.AddRetreatOrder("Russia", "War", "Mos"))
+	adjudicate(t, s)
+
+	assert.Equal(t, "W1901A", s.PhaseName())
+	assert.Equal(t, "Austria", s.World.Provinces["War"].OwnedBy.Name)
+}
+
+func TestSupplyCenters(t *testing.T) {
+	s, err := InitializeNewGame(StandardVariant)
+	assert.NoError(t, err)
+
+	centers, err := s.SupplyCenters("Russia")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Mos", "Sev", "Stp", "War"}, centers)
+
+	_, err = s.SupplyCenters("Prussia")
+	assert.ErrorIs(t, err, ErrCountryNotFound)
+
+	assert.NoError(t, s.SetOwner("Rum", "Russia"))
+	assert.NoError(t, s.SetOwner("Sev", "Turkey"))
+	assert.Equal(t, map[string]int{
+		"Austria": 3, "England": 3, "France": 3, "Germany": 3, "Italy": 3, "Russia": 4, "Turkey": 4,
+	}, s.CenterCounts())
+}
+
+func TestSetOwner_Errors(t *testing.T) {
+	s := setupAdjudicationState()
+
+	assert.ErrorIs(t, s.SetOwner("Boh", "Austria"), ErrNotSupplyCenter)
+	assert.ErrorIs(t, s.SetOwner("Xyz", "Austria"), ErrProvinceNotFound)
+	assert.ErrorIs(t, s.SetOwner("Vie", "Prussia"), ErrCountryNotFound)
+	assert.Nil(t, s.World.Provinces["Vie"].OwnedBy)
+}
@@ -0,0 +1,1781 @@
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// datcCase describes a test case of the Diplomacy Adjudicator Test Cases
+// (https://webdiplomacy.net/doc/DATC_v3_0.html). Units, orders and results
+// are written as "<Country>: <order>", e.g. "England: F NTH - Pic". Orders
+// prefixed with "!" are illegal and have to be rejected when submitted.
+//
+// A case starts in the spring movement phase unless the phase is set. The
+// retreats are ordered in the retreat phase following the movement. The board
+// lists all units after the last adjudication, dislodged lists the units
+// dislodged in the movement phase.
+type datcCase struct {
+	name      string
+	skip      string
+	phase     Phase
+	units     []string
+	centers   []string
+	orders    []string
+	dislodged []string
+	retreats  []string
+	board     []string
+}
+
+var datcCases = []datcCase{
+	// 6.A. Basic checks
+	{
+		name:   "6.A.1 Moving to an area that is not a neighbour",
+		units:  []string{"England: F NTH"},
+		orders: []string{"!England: F NTH - Pic"},
+		board:  []string{"England: F NTH"},
+	},
+	{
+		name:   "6.A.2 Move army to sea",
+		units:  []string{"England: A Lvp"},
+		orders: []string{"!England: A Lvp - IRI"},
+		board:  []string{"England: A Lvp"},
+	},
+	{
+		name:   "6.A.3 Move fleet to land",
+		units:  []string{"Germany: F Kie"},
+		orders: []string{"!Germany: F Kie - Mun"},
+		board:  []string{"Germany: F Kie"},
+	},
+	{
+		name:   "6.A.4 Move to own sector",
+		units:  []string{"Germany: F Kie"},
+		orders: []string{"!Germany: F Kie - Kie"},
+		board:  []string{"Germany: F Kie"},
+	},
+	{
+		name: "6.A.5 Move to own sector with convoy",
+		skip: "a support to a move to the own province cannot be told apart from a support to hold",
+	},
+	{
+		name:   "6.A.6 Ordering a unit of another country",
+		units:  []string{"England: F Lon"},
+		orders: []string{"!Germany: F Lon - NTH"},
+		board:  []string{"England: F Lon"},
+	},
+	{
+		name:   "6.A.7 Only armies can be convoyed",
+		units:  []string{"England: F Lon", "England: F NTH"},
+		orders: []string{"!England: F Lon - Bel", "England: F NTH C A Lon - Bel"},
+		board:  []string{"England: F Lon", "England: F NTH"},
+	},
+	{
+		name:      "6.A.8 Support to hold yourself is not possible",
+		units:     []string{"Italy: A Ven", "Italy: A Tyr", "Austria: F Tri"},
+		orders:    []string{"Italy: A Ven - Tri", "Italy: A Tyr S A Ven - Tri", "!Austria: F Tri S F Tri"},
+		dislodged: []string{"Austria: F Tri"},
+		board:     []string{"Italy: A Tri", "Italy: A Tyr"},
+	},
+	{
+		name:   "6.A.9 Fleets must follow coast if not on sea",
+		units:  []string{"Italy: F Rom"},
+		orders: []string{"!Italy: F Rom - Ven"},
+		board:  []string{"Italy: F Rom"},
+	},
+	{
+		name:   "6.A.10 Support on unreachable destination not possible",
+		units:  []string{"Austria: A Ven", "Italy: F Rom", "Italy: A Apu"},
+		orders: []string{"Austria: A Ven H", "!Italy: F Rom S A Apu - Ven", "Italy: A Apu - Ven"},
+		board:  []string{"Austria: A Ven", "Italy: F Rom", "Italy: A Apu"},
+	},
+	{
+		name:   "6.A.11 Simple bounce",
+		units:  []string{"Austria: A Vie", "Italy: A Ven"},
+		orders: []string{"Austria: A Vie - Tyr", "Italy: A Ven - Tyr"},
+		board:  []string{"Austria: A Vie", "Italy: A Ven"},
+	},
+	{
+		name:   "6.A.12 Bounce of three units",
+		units:  []string{"Austria: A Vie", "Germany: A Mun", "Italy: A Ven"},
+		orders: []string{"Austria: A Vie - Tyr", "Germany: A Mun - Tyr", "Italy: A Ven - Tyr"},
+		board:  []string{"Austria: A Vie", "Germany: A Mun", "Italy: A Ven"},
+	},
+
+	// 6.B. Coastal issues
+	{
+		name:   "6.B.1 Moving with unspecified coast when coast is necessary",
+		units:  []string{"France: F Por"},
+		orders: []string{"!France: F Por - Spa"},
+		board:  []string{"France: F Por"},
+	},
+	{
+		name:   "6.B.2 Moving with unspecified coast when coast is not necessary",
+		units:  []string{"France: F Gas"},
+		orders: []string{"France: F Gas - Spa"},
+		board:  []string{"France: F Spa/nc"},
+	},
+	{
+		name:   "6.B.3 Moving with wrong coast when coast is not necessary",
+		units:  []string{"France: F Gas"},
+		orders: []string{"!France: F Gas - Spa/sc"},
+		board:  []string{"France: F Gas"},
+	},
+	{
+		name:   "6.B.4 Support to unreachable coast allowed",
+		units:  []string{"France: F Gas", "France: F Mar", "Italy: F WES"},
+		orders: []string{"France: F Gas - Spa/nc", "France: F Mar S F Gas - Spa", "Italy: F WES - Spa/sc"},
+		board:  []string{"France: F Spa/nc", "France: F Mar", "Italy: F WES"},
+	},
+	{
+		name:   "6.B.5 Support from unreachable coast not allowed",
+		units:  []string{"France: F Mar", "France: F Spa/nc", "Italy: F LYO"},
+		orders: []string{"France: F Mar - LYO", "!France: F Spa/nc S F Mar - LYO", "Italy: F LYO H"},
+		board:  []string{"France: F Mar", "France: F Spa/nc", "Italy: F LYO"},
+	},
+	{
+		name:  "6.B.6 Support can be cut with other coast",
+		units: []string{"England: F IRI", "England: F NAO", "France: F Spa/nc", "France: F MAO", "Italy: F LYO"},
+		orders: []string{
+			"England: F IRI S F NAO - MAO", "England: F NAO - MAO",
+			"France: F Spa/nc S F MAO", "France: F MAO H",
+			"Italy: F LYO - Spa/sc",
+		},
+		dislodged: []string{"France: F MAO"},
+		board:     []string{"England: F IRI", "England: F MAO", "France: F Spa/nc", "Italy: F LYO"},
+	},
+	{
+		name:  "6.B.7 Supporting own unit with unspecified coast",
+		units: []string{"France: F Por", "France: F MAO", "Italy: F LYO", "Italy: F WES"},
+		orders: []string{
+			"France: F Por S F MAO - Spa", "France: F MAO - Spa/nc",
+			"Italy: F LYO S F WES - Spa/sc", "Italy: F WES - Spa/sc",
+		},
+		board: []string{"France: F Por", "France: F MAO", "Italy: F LYO", "Italy: F WES"},
+	},
+	{
+		name:  "6.B.8 Supporting with unspecified coast when only one coast is possible",
+		units: []string{"France: F Por", "France: F Gas", "Italy: F LYO", "Italy: F WES"},
+		orders: []string{
+			"France: F Por S F Gas - Spa", "France: F Gas - Spa/nc",
+			"Italy: F LYO S F WES - Spa/sc", "Italy: F WES - Spa/sc",
+		},
+		board: []string{"France: F Por", "France: F Gas", "Italy: F LYO", "Italy: F WES"},
+	},
+	{
+		name: "6.B.9 Supporting with wrong coast",
+		skip: "supports are given to a province and not to a coast",
+	},
+	{
+		name:   "6.B.10 Unit ordered with wrong coast",
+		units:  []string{"France: F Spa/sc"},
+		orders: []string{"France: F Spa/nc - LYO"},
+		board:  []string{"France: F LYO"},
+	},
+	{
+		name:   "6.B.11 Coast can not be ordered to change",
+		units:  []string{"France: F Spa/nc"},
+		orders: []string{"!France: F Spa/sc - LYO"},
+		board:  []string{"France: F Spa/nc"},
+	},
+	{
+		name:   "6.B.12 Army movement with coastal specification",
+		units:  []string{"France: A Gas"},
+		orders: []string{"France: A Gas - Spa/nc"},
+		board:  []string{"France: A Spa"},
+	},
+	{
+		name:   "6.B.13 Coastal crawl not allowed",
+		units:  []string{"Turkey: F Bul/sc", "Turkey: F Con"},
+		orders: []string{"Turkey: F Bul/sc - Con", "Turkey: F Con - Bul/ec"},
+		board:  []string{"Turkey: F Bul/sc", "Turkey: F Con"},
+	},
+	{
+		name:    "6.B.14 Building with unspecified coast",
+		phase:   BuildPhase,
+		centers: []string{"Russia: Stp"},
+		orders:  []string{"!Russia: F Stp B"},
+		board:   []string{},
+	},
+	{
+		name:  "6.B.15 Supporting foreign unit with unspecified coast",
+		units: []string{"France: F Por", "England: F MAO", "Italy: F LYO", "Italy: F WES"},
+		orders: []string{
+			"France: F Por S F MAO - Spa", "England: F MAO - Spa/nc",
+			"Italy: F LYO S F WES - Spa/sc", "Italy: F WES - Spa/sc",
+		},
+		board: []string{"France: F Por", "England: F MAO", "Italy: F LYO", "Italy: F WES"},
+	},
+
+	// 6.C. Circular movement
+	{
+		name:   "6.C.1 Three army circular movement",
+		units:  []string{"Turkey: F Ank", "Turkey: A Con", "Turkey: A Smy"},
+		orders: []string{"Turkey: F Ank - Con", "Turkey: A Con - Smy", "Turkey: A Smy - Ank"},
+		board:  []string{"Turkey: F Con", "Turkey: A Smy", "Turkey: A Ank"},
+	},
+	{
+		name:   "6.C.2 Three army circular movement with support",
+		units:  []string{"Turkey: F Ank", "Turkey: A Con", "Turkey: A Smy", "Turkey: A Bul"},
+		orders: []string{"Turkey: F Ank - Con", "Turkey: A Con - Smy", "Turkey: A Smy - Ank", "Turkey: A Bul S F Ank - Con"},
+		board:  []string{"Turkey: F Con", "Turkey: A Smy", "Turkey: A Ank", "Turkey: A Bul"},
+	},
+	{
+		name:   "6.C.3 A disrupted three army circular movement",
+		units:  []string{"Turkey: F Ank", "Turkey: A Con", "Turkey: A Smy", "Turkey: A Bul"},
+		orders: []string{"Turkey: F Ank - Con", "Turkey: A Con - Smy", "Turkey: A Smy - Ank", "Turkey: A Bul - Con"},
+		board:  []string{"Turkey: F Ank", "Turkey: A Con", "Turkey: A Smy", "Turkey: A Bul"},
+	},
+	{
+		name: "6.C.4 A circular movement with attacked convoy",
+		units: []string{
+			"Austria: A Tri", "Austria: A Ser",
+			"Turkey: A Bul", "Turkey: F AEG", "Turkey: F ION", "Turkey: F ADR",
+			"Italy: F Nap",
+		},
+		orders: []string{
+			"Austria: A Tri - Ser", "Austria: A Ser - Bul",
+			"Turkey: A Bul - Tri", "Turkey: F AEG C A Bul - Tri", "Turkey: F ION C A Bul - Tri", "Turkey: F ADR C A Bul - Tri",
+			"Italy: F Nap - ION",
+		},
+		board: []string{
+			"Austria: A Ser", "Austria: A Bul",
+			"Turkey: A Tri", "Turkey: F AEG", "Turkey: F ION", "Turkey: F ADR",
+			"Italy: F Nap",
+		},
+	},
+	{
+		name: "6.C.5 A disrupted circular movement due to dislodged convoy",
+		units: []string{
+			"Austria: A Tri", "Austria: A Ser",
+			"Turkey: A Bul", "Turkey: F AEG", "Turkey: F ION", "Turkey: F ADR",
+			"Italy: F Nap", "Italy: F Tun",
+		},
+		orders: []string{
+			"Austria: A Tri - Ser", "Austria: A Ser - Bul",
+			"Turkey: A Bul - Tri", "Turkey: F AEG C A Bul - Tri", "Turkey: F ION C A Bul - Tri", "Turkey: F ADR C A Bul - Tri",
+			"Italy: F Nap - ION", "Italy: F Tun S F Nap - ION",
+		},
+		dislodged: []string{"Turkey: F ION"},
+		board: []string{
+			"Austria: A Tri", "Austria: A Ser",
+			"Turkey: A Bul", "Turkey: F AEG", "Turkey: F ADR",
+			"Italy: F ION", "Italy: F Tun",
+		},
+	},
+	{
+		name:   "6.C.6 Two armies with two convoys",
+		units:  []string{"England: F NTH", "England: A Lon", "France: F ENG", "France: A Bel"},
+		orders: []string{"England: F NTH C A Lon - Bel", "England: A Lon - Bel", "France: F ENG C A Bel - Lon", "France: A Bel - Lon"},
+		board:  []string{"England: F NTH", "England: A Bel", "France: F ENG", "France: A Lon"},
+	},
+	{
+		name:  "6.C.7 Disrupted unit swap",
+		units: []string{"England: F NTH", "England: A Lon", "France: F ENG", "France: A Bel", "France: A Bur"},
+		orders: []string{
+			"England: F NTH C A Lon - Bel", "England: A Lon - Bel",
+			"France: F ENG C A Bel - Lon", "France: A Bel - Lon", "France: A Bur - Bel",
+		},
+		board: []string{"England: F NTH", "England: A Lon", "France: F ENG", "France: A Bel", "France: A Bur"},
+	},
+	{
+		name:  "6.C.8 No self dislodgement in disrupted circular movement",
+		units: []string{"Turkey: F Con", "Turkey: A Bul", "Turkey: A Smy", "Russia: F BLA", "Austria: A Ser"},
+		orders: []string{
+			"Turkey: F Con - BLA", "Turkey: A Bul - Con", "Turkey: A Smy S A Bul - Con",
+			"Russia: F BLA - Bul/ec",
+			"Austria: A Ser - Bul",
+		},
+		board: []string{"Turkey: F Con", "Turkey: A Bul", "Turkey: A Smy", "Russia: F BLA", "Austria: A Ser"},
+	},
+	{
+		name:  "6.C.9 No help in dislodgement of own unit in disrupted circular movement",
+		units: []string{"Turkey: F Con", "Turkey: A Smy", "Turkey: A Ank", "Russia: F BLA", "Austria: A Ser", "Austria: A Bul"},
+		orders: []string{
+			"Turkey: F Con - BLA", "Turkey: A Smy S A Bul - Con", "Turkey: A Ank S A Bul - Con",
+			"Russia: F BLA - Bul/ec",
+			"Austria: A Ser - Bul", "Austria: A Bul - Con",
+		},
+		board: []string{
+			"Turkey: F Con", "Turkey: A Smy", "Turkey: A Ank", "Russia: F BLA", "Austria: A Ser", "Austria: A Bul",
+		},
+	},
+
+	// 6.D. Supports and dislodges
+	{
+		name:   "6.D.1 Supported hold can prevent dislodgement",
+		units:  []string{"Austria: F ADR", "Austria: A Tri", "Italy: A Ven", "Italy: A Tyr"},
+		orders: []string{"Austria: F ADR S A Tri - Ven", "Austria: A Tri - Ven", "Italy: A Ven H", "Italy: A Tyr S A Ven"},
+		board:  []string{"Austria: F ADR", "Austria: A Tri", "Italy: A Ven", "Italy: A Tyr"},
+	},
+	{
+		name:  "6.D.2 A move cuts support on hold",
+		units: []string{"Austria: F ADR", "Austria: A Tri", "Austria: A Vie", "Italy: A Ven", "Italy: A Tyr"},
+		orders: []string{
+			"Austria: F ADR S A Tri - Ven", "Austria: A Tri - Ven", "Austria: A Vie - Tyr",
+			"Italy: A Ven H", "Italy: A Tyr S A Ven",
+		},
+		dislodged: []string{"Italy: A Ven"},
+		board:     []string{"Austria: F ADR", "Austria: A Ven", "Austria: A Vie", "Italy: A Tyr"},
+	},
+	{
+		name:   "6.D.3 A move cuts support on move",
+		units:  []string{"Austria: F ADR", "Austria: A Tri", "Italy: A Ven", "Italy: F ION"},
+		orders: []string{"Austria: F ADR S A Tri - Ven", "Austria: A Tri - Ven", "Italy: A Ven H", "Italy: F ION - ADR"},
+		board:  []string{"Austria: F ADR", "Austria: A Tri", "Italy: A Ven", "Italy: F ION"},
+	},
+	{
+		name:   "6.D.4 Support to hold on unit supporting a hold allowed",
+		units:  []string{"Germany: A Ber", "Germany: F Kie", "Russia: F BAL", "Russia: A Pru"},
+		orders: []string{"Germany: A Ber S F Kie", "Germany: F Kie S A Ber", "Russia: F BAL S A Pru - Ber", "Russia: A Pru - Ber"},
+		board:  []string{"Germany: A Ber", "Germany: F Kie", "Russia: F BAL", "Russia: A Pru"},
+	},
+	{
+		name:  "6.D.5 Support to hold on unit supporting a move allowed",
+		units: []string{"Germany: A Ber", "Germany: F Kie", "Germany: A Mun", "Russia: F BAL", "Russia: A Pru"},
+		orders: []string{
+			"Germany: A Ber S A Mun - Sil", "Germany: F Kie S A Ber", "Germany: A Mun - Sil",
+			"Russia: F BAL S A Pru - Ber", "Russia: A Pru - Ber",
+		},
+		board: []string{"Germany: A Ber", "Germany: F Kie", "Germany: A Sil", "Russia: F BAL", "Russia: A Pru"},
+	},
+	{
+		name:  "6.D.6 Support to hold on convoying unit allowed",
+		units: []string{"Germany: A Ber", "Germany: F BAL", "Germany: F Pru", "Russia: F Lvn", "Russia: F BOT"},
+		orders: []string{
+			"Germany: A Ber - Swe", "Germany: F BAL C A Ber - Swe", "Germany: F Pru S F BAL",
+			"Russia: F Lvn - BAL", "Russia: F BOT S F Lvn - BAL",
+		},
+		board: []string{"Germany: A Swe", "Germany: F BAL", "Germany: F Pru", "Russia: F Lvn", "Russia: F BOT"},
+	},
+	{
+		name:  "6.D.7 Support to hold on moving unit not allowed",
+		units: []string{"Germany: F BAL", "Germany: F Pru", "Russia: F Lvn", "Russia: F BOT", "Russia: A Fin"},
+		orders: []string{
+			"Germany: F BAL - Swe", "Germany: F Pru S F BAL",
+			"Russia: F Lvn - BAL", "Russia: F BOT S F Lvn - BAL", "Russia: A Fin - Swe",
+		},
+		dislodged: []string{"Germany: F BAL"},
+		board:     []string{"Germany: F Pru", "Russia: F BAL", "Russia: F BOT", "Russia: A Fin"},
+	},
+	{
+		name:  "6.D.8 Failed convoy can not receive hold support",
+		units: []string{"Austria: F ION", "Austria: A Ser", "Austria: A Alb", "Turkey: A Gre", "Turkey: A Bul"},
+		orders: []string{
+			"Austria: F ION H", "Austria: A Ser S A Alb - Gre", "Austria: A Alb - Gre",
+			"Turkey: A Gre - Nap", "Turkey: A Bul S A Gre",
+		},
+		dislodged: []string{"Turkey: A Gre"},
+		board:     []string{"Austria: F ION", "Austria: A Ser", "Austria: A Gre", "Turkey: A Bul"},
+	},
+	{
+		name:  "6.D.9 Support to move on holding unit not allowed",
+		units: []string{"Italy: A Ven", "Italy: A Tyr", "Austria: A Alb", "Austria: A Tri"},
+		orders: []string{
+			"Italy: A Ven - Tri", "Italy: A Tyr S A Ven - Tri",
+			"Austria: A Alb S A Tri - Ser", "Austria: A Tri H",
+		},
+		dislodged: []string{"Austria: A Tri"},
+		board:     []string{"Italy: A Tri", "Italy: A Tyr", "Austria: A Alb"},
+	},
+	{
+		name:   "6.D.10 Self dislodgment prohibited",
+		units:  []string{"Germany: A Ber", "Germany: F Kie", "Germany: A Mun"},
+		orders: []string{"Germany: A Ber H", "Germany: F Kie - Ber", "Germany: A Mun S F Kie - Ber"},
+		board:  []string{"Germany: A Ber", "Germany: F Kie", "Germany: A Mun"},
+	},
+	{
+		name:  "6.D.11 No self dislodgment of returning unit",
+		units: []string{"Germany: A Ber", "Germany: F Kie", "Germany: A Mun", "Russia: A War"},
+		orders: []string{
+			"Germany: A Ber - Pru", "Germany: F Kie - Ber", "Germany: A Mun S F Kie - Ber",
+			"Russia: A War - Pru",
+		},
+		board: []string{"Germany: A Ber", "Germany: F Kie", "Germany: A Mun", "Russia: A War"},
+	},
+	{
+		name:   "6.D.12 Supporting a foreign unit to dislodge own unit prohibited",
+		units:  []string{"Austria: F Tri", "Austria: A Vie", "Italy: A Ven"},
+		orders: []string{"Austria: F Tri H", "Austria: A Vie S A Ven - Tri", "Italy: A Ven - Tri"},
+		board:  []string{"Austria: F Tri", "Austria: A Vie", "Italy: A Ven"},
+	},
+	{
+		name:  "6.D.13 Supporting a foreign unit to dislodge a returning own unit prohibited",
+		units: []string{"Austria: F Tri", "Austria: A Vie", "Italy: A Ven", "Italy: F Apu"},
+		orders: []string{
+			"Austria: F Tri - ADR", "Austria: A Vie S A Ven - Tri",
+			"Italy: A Ven - Tri", "Italy: F Apu - ADR",
+		},
+		board: []string{"Austria: F Tri", "Austria: A Vie", "Italy: A Ven", "Italy: F Apu"},
+	},
+	{
+		name:  "6.D.14 Supporting a foreign unit is not enough to prevent dislodgement",
+		units: []string{"Austria: F Tri", "Austria: A Vie", "Italy: A Ven", "Italy: A Tyr", "Italy: F ADR"},
+		orders: []string{
+			"Austria: F Tri H", "Austria: A Vie S A Ven - Tri",
+			"Italy: A Ven - Tri", "Italy: A Tyr S A Ven - Tri", "Italy: F ADR S A Ven - Tri",
+		},
+		dislodged: []string{"Austria: F Tri"},
+		board:     []string{"Austria: A Vie", "Italy: A Tri", "Italy: A Tyr", "Italy: F ADR"},
+	},
+	{
+		name:      "6.D.15 Defender can not cut support for attack on itself",
+		units:     []string{"Russia: F Con", "Russia: F BLA", "Turkey: F Ank"},
+		orders:    []string{"Russia: F Con S F BLA - Ank", "Russia: F BLA - Ank", "Turkey: F Ank - Con"},
+		dislodged: []string{"Turkey: F Ank"},
+		board:     []string{"Russia: F Con", "Russia: F Ank"},
+	},
+	{
+		name:  "6.D.16 Convoying a unit dislodging a unit of same power is allowed",
+		units: []string{"England: A Lon", "England: F NTH", "France: F ENG", "France: A Bel"},
+		orders: []string{
+			"England: A Lon H", "England: F NTH C A Bel - Lon",
+			"France: F ENG S A Bel - Lon", "France: A Bel - Lon",
+		},
+		dislodged: []string{"England: A Lon"},
+		board:     []string{"England: F NTH", "France: F ENG", "France: A Lon"},
+	},
+	{
+		name:  "6.D.17 Dislodgement cuts supports",
+		units: []string{"Russia: F Con", "Russia: F BLA", "Turkey: F Ank", "Turkey: A Smy", "Turkey: A Arm"},
+		orders: []string{
+			"Russia: F Con S F BLA - Ank", "Russia: F BLA - Ank",
+			"Turkey: F Ank - Con", "Turkey: A Smy S F Ank - Con", "Turkey: A Arm - Ank",
+		},
+		dislodged: []string{"Russia: F Con"},
+		board:     []string{"Russia: F BLA", "Turkey: F Con", "Turkey: A Smy", "Turkey: A Arm"},
+	},
+	{
+		name:  "6.D.18 A surviving unit will sustain support",
+		units: []string{"Russia: F Con", "Russia: F BLA", "Russia: A Bul", "Turkey: F Ank", "Turkey: A Smy", "Turkey: A Arm"},
+		orders: []string{
+			"Russia: F Con S F BLA - Ank", "Russia: F BLA - Ank", "Russia: A Bul S F Con",
+			"Turkey: F Ank - Con", "Turkey: A Smy S F Ank - Con", "Turkey: A Arm - Ank",
+		},
+		dislodged: []string{"Turkey: F Ank"},
+		board:     []string{"Russia: F Con", "Russia: F Ank", "Russia: A Bul", "Turkey: A Smy", "Turkey: A Arm"},
+	},
+	{
+		name:  "6.D.19 Even when surviving is in alternative way",
+		units: []string{"Russia: F Con", "Russia: F BLA", "Russia: A Smy", "Turkey: F Ank"},
+		orders: []string{
+			"Russia: F Con S F BLA - Ank", "Russia: F BLA - Ank", "Russia: A Smy S F Ank - Con",
+			"Turkey: F Ank - Con",
+		},
+		dislodged: []string{"Turkey: F Ank"},
+		board:     []string{"Russia: F Con", "Russia: F Ank", "Russia: A Smy"},
+	},
+	{
+		name:  "6.D.20 Unit can not cut support of its own country",
+		units: []string{"England: F Lon", "England: F NTH", "England: A Yor", "France: F ENG"},
+		orders: []string{
+			"England: F Lon S F NTH - ENG", "England: F NTH - ENG", "England: A Yor - Lon",
+			"France: F ENG H",
+		},
+		dislodged: []string{"France: F ENG"},
+		board:     []string{"England: F Lon", "England: F ENG", "England: A Yor"},
+	},
+	{
+		name: "6.D.21 Dislodging does not cancel a support cut",
+		units: []string{
+			"Austria: F Tri", "Italy: A Ven", "Italy: A Tyr", "Germany: A Mun", "Russia: A Sil", "Russia: A Ber",
+		},
+		orders: []string{
+			"Austria: F Tri H",
+			"Italy: A Ven - Tri", "Italy: A Tyr S A Ven - Tri",
+			"Germany: A Mun - Tyr",
+			"Russia: A Sil - Mun", "Russia: A Ber S A Sil - Mun",
+		},
+		dislodged: []string{"Germany: A Mun"},
+		board:     []string{"Austria: F Tri", "Italy: A Ven", "Italy: A Tyr", "Russia: A Mun", "Russia: A Ber"},
+	},
+	{
+		name:  "6.D.22 Impossible fleet move can not be supported",
+		units: []string{"Germany: F Kie", "Germany: A Bur", "Russia: A Mun", "Russia: A Ber"},
+		orders: []string{
+			"!Germany: F Kie - Mun", "Germany: A Bur S F Kie - Mun",
+			"Russia: A Mun - Kie", "Russia: A Ber S A Mun - Kie",
+		},
+		dislodged: []string{"Germany: F Kie"},
+		board:     []string{"Germany: A Bur", "Russia: A Kie", "Russia: A Ber"},
+	},
+	{
+		name:  "6.D.23 Impossible coast move can not be supported",
+		units: []string{"Italy: F LYO", "Italy: F WES", "France: F Spa/nc", "France: F Mar"},
+		orders: []string{
+			"Italy: F LYO - Spa/sc", "Italy: F WES S F LYO - Spa/sc",
+			"!France: F Spa/nc - LYO", "France: F Mar S F Spa - LYO",
+		},
+		dislodged: []string{"France: F Spa/nc"},
+		board:     []string{"Italy: F Spa/sc", "Italy: F WES", "France: F Mar"},
+	},
+	{
+		name:  "6.D.24 Impossible army move can not be supported",
+		units: []string{"France: A Mar", "France: F Spa/sc", "Italy: F LYO", "Turkey: F TYS", "Turkey: F WES"},
+		orders: []string{
+			"!France: A Mar - LYO", "France: F Spa/sc S A Mar - LYO",
+			"Italy: F LYO H",
+			"Turkey: F TYS S F WES - LYO", "Turkey: F WES - LYO",
+		},
+		dislodged: []string{"Italy: F LYO"},
+		board:     []string{"France: A Mar", "France: F Spa/sc", "Turkey: F TYS", "Turkey: F LYO"},
+	},
+	{
+		name:   "6.D.25 Failing hold support can be supported",
+		units:  []string{"Germany: A Ber", "Germany: F Kie", "Russia: F BAL", "Russia: A Pru"},
+		orders: []string{"Germany: A Ber S A Pru", "Germany: F Kie S A Ber", "Russia: F BAL S A Pru - Ber", "Russia: A Pru - Ber"},
+		board:  []string{"Germany: A Ber", "Germany: F Kie", "Russia: F BAL", "Russia: A Pru"},
+	},
+	{
+		name:   "6.D.26 Failing move support can be supported",
+		units:  []string{"Germany: A Ber", "Germany: F Kie", "Russia: F BAL", "Russia: A Pru"},
+		orders: []string{"Germany: A Ber S A Pru - Sil", "Germany: F Kie S A Ber", "Russia: F BAL S A Pru - Ber", "Russia: A Pru - Ber"},
+		board:  []string{"Germany: A Ber", "Germany: F Kie", "Russia: F BAL", "Russia: A Pru"},
+	},
+	{
+		name:  "6.D.27 Failing convoy can be supported",
+		units: []string{"England: F Swe", "England: F Den", "Germany: A Ber", "Russia: F BAL", "Russia: F Pru"},
+		orders: []string{
+			"England: F Swe - BAL", "England: F Den S F Swe - BAL",
+			"Germany: A Ber H",
+			"Russia: F BAL C A Ber - Lvn", "Russia: F Pru S F BAL",
+		},
+		board: []string{"England: F Swe", "England: F Den", "Germany: A Ber", "Russia: F BAL", "Russia: F Pru"},
+	},
+	{
+		name:  "6.D.28 Impossible move and support",
+		units: []string{"Austria: A Bud", "Russia: F Rum", "Turkey: F BLA", "Turkey: A Bul"},
+		orders: []string{
+			"Austria: A Bud S F Rum",
+			"!Russia: F Rum - Hol",
+			"Turkey: F BLA - Rum", "Turkey: A Bul S F BLA - Rum",
+		},
+		board: []string{"Austria: A Bud", "Russia: F Rum", "Turkey: F BLA", "Turkey: A Bul"},
+	},
+	{
+		name:  "6.D.29 Move to impossible coast and support",
+		units: []string{"Austria: A Bud", "Russia: F Rum", "Turkey: F BLA", "Turkey: A Bul"},
+		orders: []string{
+			"Austria: A Bud S F Rum",
+			"!Russia: F Rum - Bul/sc",
+			"Turkey: F BLA - Rum", "Turkey: A Bul S F BLA - Rum",
+		},
+		board: []string{"Austria: A Bud", "Russia: F Rum", "Turkey: F BLA", "Turkey: A Bul"},
+	},
+	{
+		name:  "6.D.30 Move without coast and support",
+		units: []string{"Italy: F AEG", "Russia: F Con", "Turkey: F BLA", "Turkey: A Bul"},
+		orders: []string{
+			"Italy: F AEG S F Con",
+			"!Russia: F Con - Bul",
+			"Turkey: F BLA - Con", "Turkey: A Bul S F BLA - Con",
+		},
+		board: []string{"Italy: F AEG", "Russia: F Con", "Turkey: F BLA", "Turkey: A Bul"},
+	},
+	{
+		name:   "6.D.31 A tricky impossible support",
+		units:  []string{"Austria: A Rum", "Turkey: F BLA"},
+		orders: []string{"Austria: A Rum - Arm", "Turkey: F BLA S A Rum - Arm"},
+		board:  []string{"Austria: A Rum", "Turkey: F BLA"},
+	},
+	{
+		name:  "6.D.32 A missing fleet",
+		units: []string{"England: F Edi", "England: A Lvp", "France: F Lon", "Germany: A Yor"},
+		orders: []string{
+			"England: F Edi S A Lvp - Yor", "England: A Lvp - Yor",
+			"France: F Lon S A Yor",
+			"Germany: A Yor - Hol",
+		},
+		dislodged: []string{"Germany: A Yor"},
+		board:     []string{"England: F Edi", "England: A Yor", "France: F Lon"},
+	},
+	{
+		name:  "6.D.33 Unwanted support allowed",
+		units: []string{"Austria: A Ser", "Austria: A Vie", "Russia: A Gal", "Turkey: A Bul"},
+		orders: []string{
+			"Austria: A Ser - Bud", "Austria: A Vie - Bud",
+			"Russia: A Gal S A Ser - Bud",
+			"Turkey: A Bul - Ser",
+		},
+		board: []string{"Austria: A Bud", "Austria: A Vie", "Russia: A Gal", "Turkey: A Ser"},
+	},
+	{
+		name:  "6.D.34 Support targeting own area not allowed",
+		units: []string{"Germany: A Ber", "Germany: A Sil", "Germany: F BAL", "Italy: A Pru", "Russia: A War", "Russia: A Lvn"},
+		orders: []string{
+			"Germany: A Ber - Pru", "Germany: A Sil S A Ber - Pru", "Germany: F BAL S A Ber - Pru",
+			"!Italy: A Pru S A Lvn - Pru",
+			"Russia: A War S A Lvn - Pru", "Russia: A Lvn - Pru",
+		},
+		dislodged: []string{"Italy: A Pru"},
+		board:     []string{"Germany: A Pru", "Germany: A Sil", "Germany: F BAL", "Russia: A War", "Russia: A Lvn"},
+	},
+
+	// 6.E. Head-to-head battles and beleaguered garrisons
+	{
+		name:  "6.E.1 Dislodged unit has no effect on attacker's area",
+		units: []string{"Germany: A Ber", "Germany: F Kie", "Germany: A Sil", "Russia: A Pru"},
+		orders: []string{
+			"Germany: A Ber - Pru", "Germany: F Kie - Ber", "Germany: A Sil S A Ber - Pru",
+			"Russia: A Pru - Ber",
+		},
+		dislodged: []string{"Russia: A Pru"},
+		board:     []string{"Germany: A Pru", "Germany: F Ber", "Germany: A Sil"},
+	},
+	{
+		name:   "6.E.2 No self dislodgement in head to head battle",
+		units:  []string{"Germany: A Ber", "Germany: F Kie", "Germany: A Mun"},
+		orders: []string{"Germany: A Ber - Kie", "Germany: F Kie - Ber", "Germany: A Mun S A Ber - Kie"},
+		board:  []string{"Germany: A Ber", "Germany: F Kie", "Germany: A Mun"},
+	},
+	{
+		name:   "6.E.3 No help in dislodging own unit",
+		units:  []string{"Germany: A Ber", "Germany: A Mun", "England: F Kie"},
+		orders: []string{"Germany: A Ber - Kie", "Germany: A Mun S F Kie - Ber", "England: F Kie - Ber"},
+		board:  []string{"Germany: A Ber", "Germany: A Mun", "England: F Kie"},
+	},
+	{
+		name: "6.E.4 Non-dislodged loser has still effect",
+		units: []string{
+			"Germany: F Hol", "Germany: F HEL", "Germany: F SKA",
+			"France: F NTH", "France: F Bel",
+			"England: F Edi", "England: F Yor", "England: F NWG",
+			"Austria: A Kie", "Austria: A Ruh",
+		},
+		orders: []string{
+			"Germany: F Hol - NTH", "Germany: F HEL S F Hol - NTH", "Germany: F SKA S F Hol - NTH",
+			"France: F NTH - Hol", "France: F Bel S F NTH - Hol",
+			"England: F Edi S F NWG - NTH", "England: F Yor S F NWG - NTH", "England: F NWG - NTH",
+			"Austria: A Kie S A Ruh - Hol", "Austria: A Ruh - Hol",
+		},
+		board: []string{
+			"Germany: F Hol", "Germany: F HEL", "Germany: F SKA",
+			"France: F NTH", "France: F Bel",
+			"England: F Edi", "England: F Yor", "England: F NWG",
+			"Austria: A Kie", "Austria: A Ruh",
+		},
+	},
+	{
+		name: "6.E.5 Loser dislodged by another army has still effect",
+		units: []string{
+			"Germany: F Hol", "Germany: F HEL", "Germany: F SKA",
+			"France: F NTH", "France: F Bel",
+			"England: F Edi", "England: F Yor", "England: F NWG", "England: F Lon",
+			"Austria: A Kie", "Austria: A Ruh",
+		},
+		orders: []string{
+			"Germany: F Hol - NTH", "Germany: F HEL S F Hol - NTH", "Germany: F SKA S F Hol - NTH",
+			"France: F NTH - Hol", "France: F Bel S F NTH - Hol",
+			"England: F Edi S F NWG - NTH", "England: F Yor S F NWG - NTH", "England: F NWG - NTH", "England: F Lon S F NWG - NTH",
+			"Austria: A Kie S A Ruh - Hol", "Austria: A Ruh - Hol",
+		},
+		dislodged: []string{"France: F NTH"},
+		board: []string{
+			"Germany: F Hol", "Germany: F HEL", "Germany: F SKA",
+			"France: F Bel",
+			"England: F Edi", "England: F Yor", "England: F NTH", "England: F Lon",
+			"Austria: A Kie", "Austria: A Ruh",
+		},
+	},
+	{
+		name: "6.E.6 Not dislodge because of own support has still effect",
+		units: []string{
+			"Germany: F Hol", "Germany: F HEL",
+			"France: F NTH", "France: F Bel", "France: F ENG",
+			"Austria: A Kie", "Austria: A Ruh",
+		},
+		orders: []string{
+			"Germany: F Hol - NTH", "Germany: F HEL S F Hol - NTH",
+			"France: F NTH - Hol", "France: F Bel S F NTH - Hol", "France: F ENG S F Hol - NTH",
+			"Austria: A Kie S A Ruh - Hol", "Austria: A Ruh - Hol",
+		},
+		board: []string{
+			"Germany: F Hol", "Germany: F HEL",
+			"France: F NTH", "France: F Bel", "France: F ENG",
+			"Austria: A Kie", "Austria: A Ruh",
+		},
+	},
+	{
+		name:  "6.E.7 No self dislodgement with beleaguered garrison",
+		units: []string{"England: F NTH", "England: F Yor", "Germany: F Hol", "Germany: F HEL", "Russia: F SKA", "Russia: F Nwy"},
+		orders: []string{
+			"England: F NTH H", "England: F Yor S F Nwy - NTH",
+			"Germany: F Hol S F HEL - NTH", "Germany: F HEL - NTH",
+			"Russia: F SKA S F Nwy - NTH", "Russia: F Nwy - NTH",
+		},
+		board: []string{"England: F NTH", "England: F Yor", "Germany: F Hol", "Germany: F HEL", "Russia: F SKA", "Russia: F Nwy"},
+	},
+	{
+		name:  "6.E.8 No self dislodgement with beleaguered garrison and head to head battle",
+		units: []string{"England: F NTH", "England: F Yor", "Germany: F Hol", "Germany: F HEL", "Russia: F SKA", "Russia: F Nwy"},
+		orders: []string{
+			"England: F NTH - Nwy", "England: F Yor S F Nwy - NTH",
+			"Germany: F Hol S F HEL - NTH", "Germany: F HEL - NTH",
+			"Russia: F SKA S F Nwy - NTH", "Russia: F Nwy - NTH",
+		},
+		board: []string{"England: F NTH", "England: F Yor", "Germany: F Hol", "Germany: F HEL", "Russia: F SKA", "Russia: F Nwy"},
+	},
+	{
+		name:  "6.E.9 Almost self dislodgement with beleaguered garrison",
+		units: []string{"England: F NTH", "England: F Yor", "Germany: F Hol", "Germany: F HEL", "Russia: F SKA", "Russia: F Nwy"},
+		orders: []string{
+			"England: F NTH - NWG", "England: F Yor S F Nwy - NTH",
+			"Germany: F Hol S F HEL - NTH", "Germany: F HEL - NTH",
+			"Russia: F SKA S F Nwy - NTH", "Russia: F Nwy - NTH",
+		},
+		board: []string{"England: F NWG", "England: F Yor", "Germany: F Hol", "Germany: F HEL", "Russia: F SKA", "Russia: F NTH"},
+	},
+	{
+		name:  "6.E.10 Almost circular movement with no self dislodgement with beleaguered garrison",
+		units: []string{"England: F NTH", "England: F Yor", "Germany: F Hol", "Germany: F HEL", "Germany: F Den", "Russia: F SKA", "Russia: F Nwy"},
+		orders: []string{
+			"England: F NTH - Den", "England: F Yor S F Nwy - NTH",
+			"Germany: F Hol S F HEL - NTH", "Germany: F HEL - NTH", "Germany: F Den - HEL",
+			"Russia: F SKA S F Nwy - NTH", "Russia: F Nwy - NTH",
+		},
+		board: []string{"England: F NTH", "England: F Yor", "Germany: F Hol", "Germany: F HEL", "Germany: F Den", "Russia: F SKA", "Russia: F Nwy"},
+	},
+	{
+		name: "6.E.11 No self dislodgement with beleaguered garrison, unit swap with adjacent convoying and two coasts",
+		units: []string{
+			"France: A Spa", "France: F MAO", "France: F LYO",
+			"Germany: A Mar", "Germany: A Gas",
+			"Italy: F Por", "Italy: F WES",
+		},
+		orders: []string{
+			"France: A Spa - Por via Convoy", "France: F MAO C A Spa - Por", "France: F LYO S F Por - Spa/nc",
+			"Germany: A Mar S A Gas - Spa", "Germany: A Gas - Spa",
+			"Italy: F Por - Spa/nc", "Italy: F WES S F Por - Spa/nc",
+		},
+		board: []string{
+			"France: A Por", "France: F MAO", "France: F LYO",
+			"Germany: A Mar", "Germany: A Gas",
+			"Italy: F Spa/nc", "Italy: F WES",
+		},
+	},
+	{
+		name:  "6.E.12 Support on attack on own unit can be used for other means",
+		units: []string{"Austria: A Bud", "Austria: A Ser", "Italy: A Vie", "Russia: A Gal", "Russia: A Rum"},
+		orders: []string{
+			"Austria: A Bud - Rum", "Austria: A Ser S A Vie - Bud",
+			"Italy: A Vie - Bud",
+			"Russia: A Gal - Bud", "Russia: A Rum S A Gal - Bud",
+		},
+		board: []string{"Austria: A Bud", "Austria: A Ser", "Italy: A Vie", "Russia: A Gal", "Russia: A Rum"},
+	},
+	{
+		name: "6.E.13 Three way beleaguered garrison",
+		units: []string{
+			"England: F Edi", "England: F Yor", "France: F Bel", "France: F ENG",
+			"Germany: F NTH", "Russia: F NWG", "Russia: F Nwy",
+		},
+		orders: []string{
+			"England: F Edi S F Yor - NTH", "England: F Yor - NTH",
+			"France: F Bel - NTH", "France: F ENG S F Bel - NTH",
+			"Germany: F NTH H",
+			"Russia: F NWG - NTH", "Russia: F Nwy S F NWG - NTH",
+		},
+		board: []string{
+			"England: F Edi", "England: F Yor", "France: F Bel", "France: F ENG",
+			"Germany: F NTH", "Russia: F NWG", "Russia: F Nwy",
+		},
+	},
+	{
+		name:   "6.E.14 Illegal head to head battle can still defend",
+		units:  []string{"England: A Lvp", "Russia: F Edi"},
+		orders: []string{"England: A Lvp - Edi", "!Russia: F Edi - Lvp"},
+		board:  []string{"England: A Lvp", "Russia: F Edi"},
+	},
+	{
+		name: "6.E.15 The friendly head to head battle",
+		units: []string{
+			"England: F Hol", "England: A Ruh",
+			"France: A Kie", "France: A Mun", "France: A Sil",
+			"Germany: A Ber", "Germany: F Den", "Germany: F HEL",
+			"Russia: F BAL", "Russia: A Pru",
+		},
+		orders: []string{
+			"England: F Hol S A Ruh - Kie", "England: A Ruh - Kie",
+			"France: A Kie - Ber", "France: A Mun S A Kie - Ber", "France: A Sil S A Kie - Ber",
+			"Germany: A Ber - Kie", "Germany: F Den S A Ber - Kie", "Germany: F HEL S A Ber - Kie",
+			"Russia: F BAL S A Pru - Ber", "Russia: A Pru - Ber",
+		},
+		board: []string{
+			"England: F Hol", "England: A Ruh",
+			"France: A Kie", "France: A Mun", "France: A Sil",
+			"Germany: A Ber", "Germany: F Den", "Germany: F HEL",
+			"Russia: F BAL", "Russia: A Pru",
+		},
+	},
+
+	// 6.F. Convoys
+	{
+		name:  "6.F.1 No convoy in coastal areas",
+		units: []string{"Turkey: A Gre", "Turkey: F AEG", "Turkey: F Con", "Turkey: F BLA"},
+		orders: []string{
+			"Turkey: A Gre - Sev", "Turkey: F AEG C A Gre - Sev", "!Turkey: F Con C A Gre - Sev", "Turkey: F BLA C A Gre - Sev",
+		},
+		board: []string{"Turkey: A Gre", "Turkey: F AEG", "Turkey: F Con", "Turkey: F BLA"},
+	},
+	{
+		name:   "6.F.2 An army being convoyed can bounce as normal",
+		units:  []string{"England: F ENG", "England: A Lon", "France: A Par"},
+		orders: []string{"England: F ENG C A Lon - Bre", "England: A Lon - Bre", "France: A Par - Bre"},
+		board:  []string{"England: F ENG", "England: A Lon", "France: A Par"},
+	},
+	{
+		name:  "6.F.3 An army being convoyed can receive support",
+		units: []string{"England: F ENG", "England: A Lon", "England: F MAO", "France: A Par"},
+		orders: []string{
+			"England: F ENG C A Lon - Bre", "England: A Lon - Bre", "England: F MAO S A Lon - Bre",
+			"France: A Par - Bre",
+		},
+		board: []string{"England: F ENG", "England: A Bre", "England: F MAO", "France: A Par"},
+	},
+	{
+		name:   "6.F.4 An attacked convoy is not disrupted",
+		units:  []string{"England: F NTH", "England: A Lon", "Germany: F SKA"},
+		orders: []string{"England: F NTH C A Lon - Hol", "England: A Lon - Hol", "Germany: F SKA - NTH"},
+		board:  []string{"England: F NTH", "England: A Hol", "Germany: F SKA"},
+	},
+	{
+		name: "6.F.5 A beleaguered convoy is not disrupted",
+		units: []string{
+			"England: F NTH", "England: A Lon", "France: F ENG", "France: F Bel", "Germany: F SKA", "Germany: F Den",
+		},
+		orders: []string{
+			"England: F NTH C A Lon - Hol", "England: A Lon - Hol",
+			"France: F ENG - NTH", "France: F Bel S F ENG - NTH",
+			"Germany: F SKA - NTH", "Germany: F Den S F SKA - NTH",
+		},
+		board: []string{
+			"England: F NTH", "England: A Hol", "France: F ENG", "France: F Bel", "Germany: F SKA", "Germany: F Den",
+		},
+	},
+	{
+		name: "6.F.6 Dislodged convoy does not cut support",
+		units: []string{
+			"England: F NTH", "England: A Lon",
+			"Germany: A Hol", "Germany: A Bel", "Germany: F HEL", "Germany: F SKA",
+			"France: A Pic", "France: A Bur",
+		},
+		orders: []string{
+			"England: F NTH C A Lon - Hol", "England: A Lon - Hol",
+			"Germany: A Hol S A Bel", "Germany: A Bel S A Hol", "Germany: F HEL S F SKA - NTH", "Germany: F SKA - NTH",
+			"France: A Pic - Bel", "France: A Bur S A Pic - Bel",
+		},
+		dislodged: []string{"England: F NTH"},
+		board: []string{
+			"England: A Lon",
+			"Germany: A Hol", "Germany: A Bel", "Germany: F HEL", "Germany: F NTH",
+			"France: A Pic", "France: A Bur",
+		},
+	},
+	{
+		name:  "6.F.7 Dislodged convoy does not cause contested area",
+		units: []string{"England: F NTH", "England: A Lon", "Germany: F HEL", "Germany: F SKA"},
+		orders: []string{
+			"England: F NTH C A Lon - Hol", "England: A Lon - Hol",
+			"Germany: F HEL S F SKA - NTH", "Germany: F SKA - NTH",
+		},
+		dislodged: []string{"England: F NTH"},
+		retreats:  []string{"England: F NTH R Hol"},
+		board:     []string{"England: F Hol", "England: A Lon", "Germany: F HEL", "Germany: F NTH"},
+	},
+	{
+		name:  "6.F.8 Dislodged convoy does not cause a bounce",
+		units: []string{"England: F NTH", "England: A Lon", "Germany: F HEL", "Germany: F SKA", "Germany: A Bel"},
+		orders: []string{
+			"England: F NTH C A Lon - Hol", "England: A Lon - Hol",
+			"Germany: F HEL S F SKA - NTH", "Germany: F SKA - NTH", "Germany: A Bel - Hol",
+		},
+		dislodged: []string{"England: F NTH"},
+		board:     []string{"England: A Lon", "Germany: F HEL", "Germany: F NTH", "Germany: A Hol"},
+	},
+	{
+		name:  "6.F.9 Dislodge of multi-route convoy",
+		units: []string{"England: F ENG", "England: F NTH", "England: A Lon", "France: F Bre", "France: F MAO"},
+		orders: []string{
+			"England: F ENG C A Lon - Bel", "England: F NTH C A Lon - Bel", "England: A Lon - Bel",
+			"France: F Bre S F MAO - ENG", "France: F MAO - ENG",
+		},
+		dislodged: []string{"England: F ENG"},
+		board:     []string{"England: F NTH", "England: A Bel", "France: F Bre", "France: F ENG"},
+	},
+	{
+		name:  "6.F.10 Dislodge of multi-route convoy with foreign fleet",
+		units: []string{"England: F NTH", "England: A Lon", "Germany: F ENG", "France: F Bre", "France: F MAO"},
+		orders: []string{
+			"England: F NTH C A Lon - Bel", "England: A Lon - Bel",
+			"Germany: F ENG C A Lon - Bel",
+			"France: F Bre S F MAO - ENG", "France: F MAO - ENG",
+		},
+		dislodged: []string{"Germany: F ENG"},
+		board:     []string{"England: F NTH", "England: A Bel", "France: F Bre", "France: F ENG"},
+	},
+	{
+		name:  "6.F.11 Dislodge of multi-route convoy with only foreign fleets",
+		units: []string{"England: A Lon", "Germany: F ENG", "Russia: F NTH", "France: F Bre", "France: F MAO"},
+		orders: []string{
+			"England: A Lon - Bel",
+			"Germany: F ENG C A Lon - Bel",
+			"Russia: F NTH C A Lon - Bel",
+			"France: F Bre S F MAO - ENG", "France: F MAO - ENG",
+		},
+		dislodged: []string{"Germany: F ENG"},
+		board:     []string{"England: A Bel", "Russia: F NTH", "France: F Bre", "France: F ENG"},
+	},
+	{
+		name:  "6.F.12 Dislodged convoying fleet not on route",
+		units: []string{"England: F ENG", "England: A Lon", "England: F IRI", "France: F NAO", "France: F MAO"},
+		orders: []string{
+			"England: F ENG C A Lon - Bel", "England: A Lon - Bel", "England: F IRI C A Lon - Bel",
+			"France: F NAO S F MAO - IRI", "France: F MAO - IRI",
+		},
+		dislodged: []string{"England: F IRI"},
+		board:     []string{"England: F ENG", "England: A Bel", "France: F NAO", "France: F IRI"},
+	},
+	{
+		name:  "6.F.13 The unwanted alternative",
+		units: []string{"England: A Lon", "England: F NTH", "France: F ENG", "Germany: F Hol", "Germany: F Den"},
+		orders: []string{
+			"England: A Lon - Bel", "England: F NTH C A Lon - Bel",
+			"France: F ENG C A Lon - Bel",
+			"Germany: F Hol S F Den - NTH", "Germany: F Den - NTH",
+		},
+		dislodged: []string{"England: F NTH"},
+		board:     []string{"England: A Bel", "France: F ENG", "Germany: F Hol", "Germany: F NTH"},
+	},
+	{
+		name:  "6.F.14 Simple convoy paradox",
+		units: []string{"England: F Lon", "England: F Wal", "France: A Bre", "France: F ENG"},
+		orders: []string{
+			"England: F Lon S F Wal - ENG", "England: F Wal - ENG",
+			"France: A Bre - Lon", "France: F ENG C A Bre - Lon",
+		},
+		dislodged: []string{"France: F ENG"},
+		board:     []string{"England: F Lon", "England: F ENG", "France: A Bre"},
+	},
+	{
+		name: "6.F.15 Simple convoy paradox with additional convoy",
+		units: []string{
+			"England: F Lon", "England: F Wal", "France: A Bre", "France: F ENG",
+			"Italy: F IRI", "Italy: F MAO", "Italy: A Naf",
+		},
+		orders: []string{
+			"England: F Lon S F Wal - ENG", "England: F Wal - ENG",
+			"France: A Bre - Lon", "France: F ENG C A Bre - Lon",
+			"Italy: F IRI C A Naf - Wal", "Italy: F MAO C A Naf - Wal", "Italy: A Naf - Wal",
+		},
+		dislodged: []string{"France: F ENG"},
+		board: []string{
+			"England: F Lon", "England: F ENG", "France: A Bre",
+			"Italy: F IRI", "Italy: F MAO", "Italy: A Wal",
+		},
+	},
+	{
+		name: "6.F.16 Pandin's paradox",
+		units: []string{
+			"England: F Lon", "England: F Wal", "France: A Bre", "France: F ENG", "Germany: F NTH", "Germany: F Bel",
+		},
+		orders: []string{
+			"England: F Lon S F Wal - ENG", "England: F Wal - ENG",
+			"France: A Bre - Lon", "France: F ENG C A Bre - Lon",
+			"Germany: F NTH S F Bel - ENG", "Germany: F Bel - ENG",
+		},
+		board: []string{
+			"England: F Lon", "England: F Wal", "France: A Bre", "France: F ENG", "Germany: F NTH", "Germany: F Bel",
+		},
+	},
+	{
+		name: "6.F.17 Pandin's extended paradox",
+		units: []string{
+			"England: F Lon", "England: F Wal", "France: A Bre", "France: F ENG", "France: F Yor",
+			"Germany: F NTH", "Germany: F Bel",
+		},
+		orders: []string{
+			"England: F Lon S F Wal - ENG", "England: F Wal - ENG",
+			"France: A Bre - Lon", "France: F ENG C A Bre - Lon", "France: F Yor S A Bre - Lon",
+			"Germany: F NTH S F Bel - ENG", "Germany: F Bel - ENG",
+		},
+		board: []string{
+			"England: F Lon", "England: F Wal", "France: A Bre", "France: F ENG", "France: F Yor",
+			"Germany: F NTH", "Germany: F Bel",
+		},
+	},
+	{
+		name: "6.F.18 Betrayal paradox",
+		units: []string{
+			"England: F NTH", "England: A Lon", "England: F ENG", "France: F Bel", "Germany: F HEL", "Germany: F SKA",
+		},
+		orders: []string{
+			"England: F NTH C A Lon - Bel", "England: A Lon - Bel", "England: F ENG S A Lon - Bel",
+			"France: F Bel S F NTH",
+			"Germany: F HEL S F SKA - NTH", "Germany: F SKA - NTH",
+		},
+		board: []string{
+			"England: F NTH", "England: A Lon", "England: F ENG", "France: F Bel", "Germany: F HEL", "Germany: F SKA",
+		},
+	},
+	{
+		name:  "6.F.19 Multi-route convoy disruption paradox",
+		units: []string{"France: A Tun", "France: F TYS", "France: F ION", "Italy: F Nap", "Italy: F Rom"},
+		orders: []string{
+			"France: A Tun - Nap", "France: F TYS C A Tun - Nap", "France: F ION C A Tun - Nap",
+			"Italy: F Nap S F Rom - TYS", "Italy: F Rom - TYS",
+		},
+		board: []string{"France: A Tun", "France: F TYS", "France: F ION", "Italy: F Nap", "Italy: F Rom"},
+	},
+	{
+		name: "6.F.20 Unwanted multi-route convoy paradox",
+		units: []string{
+			"France: A Tun", "France: F TYS", "Italy: F Nap", "Italy: F ION", "Turkey: F AEG", "Turkey: F EAS",
+		},
+		orders: []string{
+			"France: A Tun - Nap", "France: F TYS C A Tun - Nap",
+			"Italy: F Nap S F ION", "Italy: F ION C A Tun - Nap",
+			"Turkey: F AEG S F EAS - ION", "Turkey: F EAS - ION",
+		},
+		dislodged: []string{"Italy: F ION"},
+		board: []string{
+			"France: A Tun", "France: F TYS", "Italy: F Nap", "Turkey: F AEG", "Turkey: F ION",
+		},
+	},
+	{
+		name: "6.F.21 Dad's army convoy",
+		units: []string{
+			"Russia: A Edi", "Russia: F NWG", "Russia: A Nwy", "France: F IRI", "France: F MAO",
+			"England: A Lvp", "England: F NAO", "England: F Cly",
+		},
+		orders: []string{
+			"Russia: A Edi S A Nwy - Cly", "Russia: F NWG C A Nwy - Cly", "Russia: A Nwy - Cly",
+			"France: F IRI S F MAO - NAO", "France: F MAO - NAO",
+			"England: A Lvp - Cly via Convoy", "England: F NAO C A Lvp - Cly", "England: F Cly S F NAO",
+		},
+		dislodged: []string{"England: F NAO", "England: F Cly"},
+		board: []string{
+			"Russia: A Edi", "Russia: F NWG", "Russia: A Cly", "France: F IRI", "France: F NAO", "England: A Lvp",
+		},
+	},
+	{
+		name: "6.F.22 Second order paradox with two resolutions",
+		units: []string{
+			"England: F Edi", "England: F Lon", "France: A Bre", "France: F ENG",
+			"Germany: F Bel", "Germany: F Pic", "Russia: A Nwy", "Russia: F NTH",
+		},
+		orders: []string{
+			"England: F Edi - NTH", "England: F Lon S F Edi - NTH",
+			"France: A Bre - Lon", "France: F ENG C A Bre - Lon",
+			"Germany: F Bel S F Pic - ENG", "Germany: F Pic - ENG",
+			"Russia: A Nwy - Bel", "Russia: F NTH C A Nwy - Bel",
+		},
+		dislodged: []string{"France: F ENG", "Russia: F NTH"},
+		board: []string{
+			"England: F NTH", "England: F Lon", "France: A Bre", "Germany: F Bel", "Germany: F ENG", "Russia: A Nwy",
+		},
+	},
+	{
+		name: "6.F.23 Second order paradox with two exclusive convoys",
+		units: []string{
+			"England: F Edi", "England: F Yor", "France: A Bre", "France: F ENG", "Germany: F Bel", "Germany: F Lon",
+			"Italy: F MAO", "Italy: F IRI", "Russia: A Nwy", "Russia: F NTH",
+		},
+		orders: []string{
+			"England: F Edi - NTH", "England: F Yor S F Edi - NTH",
+			"France: A Bre - Lon", "France: F ENG C A Bre - Lon",
+			"Germany: F Bel S F ENG", "Germany: F Lon S F NTH",
+			"Italy: F MAO - ENG", "Italy: F IRI S F MAO - ENG",
+			"Russia: A Nwy - Bel", "Russia: F NTH C A Nwy - Bel",
+		},
+		board: []string{
+			"England: F Edi", "England: F Yor", "France: A Bre", "France: F ENG", "Germany: F Bel", "Germany: F Lon",
+			"Italy: F MAO", "Italy: F IRI", "Russia: A Nwy", "Russia: F NTH",
+		},
+	},
+	{
+		name: "6.F.24 Second order paradox with no resolution",
+		units: []string{
+			"England: F Edi", "England: F Lon", "England: F IRI", "England: F MAO",
+			"France: A Bre", "France: F ENG", "France: F Bel", "Russia: F NTH", "Russia: A Nwy",
+		},
+		orders: []string{
+			"England: F Edi - NTH", "England: F Lon S F Edi - NTH",
+			"England: F IRI - ENG", "England: F MAO S F IRI - ENG",
+			"France: A Bre - Lon", "France: F ENG C A Bre - Lon", "France: F Bel S F ENG",
+			"Russia: F NTH C A Nwy - Bel", "Russia: A Nwy - Bel",
+		},
+		dislodged: []string{"Russia: F NTH"},
+		board: []string{
+			"England: F NTH", "England: F Lon", "England: F IRI", "England: F MAO",
+			"France: A Bre", "France: F ENG", "France: F Bel", "Russia: A Nwy",
+		},
+	},
+
+	// 6.G. Convoying to adjacent places
+	{
+		name:   "6.G.1 Two units can swap places by convoy",
+		units:  []string{"England: A Nwy", "England: F SKA", "Russia: A Swe"},
+		orders: []string{"England: A Nwy - Swe", "England: F SKA C A Nwy - Swe", "Russia: A Swe - Nwy"},
+		board:  []string{"England: A Swe", "England: F SKA", "Russia: A Nwy"},
+	},
+	{
+		name:   "6.G.2 Kidnapping an army",
+		units:  []string{"England: A Nwy", "Russia: F Swe", "Germany: F SKA"},
+		orders: []string{"England: A Nwy - Swe", "Russia: F Swe - Nwy", "Germany: F SKA C A Nwy - Swe"},
+		board:  []string{"England: A Nwy", "Russia: F Swe", "Germany: F SKA"},
+	},
+	{
+		name:  "6.G.3 Kidnapping with a disrupted convoy",
+		units: []string{"France: F Bre", "France: A Pic", "France: A Bur", "France: F MAO", "England: F ENG"},
+		orders: []string{
+			"France: F Bre - ENG", "France: A Pic - Bel", "France: A Bur S A Pic - Bel", "France: F MAO S F Bre - ENG",
+			"England: F ENG C A Pic - Bel",
+		},
+		dislodged: []string{"England: F ENG"},
+		board:     []string{"France: F ENG", "France: A Bel", "France: A Bur", "France: F MAO"},
+	},
+	{
+		name: "6.G.4 Kidnapping with a disrupted convoy and opposite move",
+		units: []string{
+			"France: F Bre", "France: A Pic", "France: A Bur", "France: F MAO", "England: F ENG", "England: A Bel",
+		},
+		orders: []string{
+			"France: F Bre - ENG", "France: A Pic - Bel", "France: A Bur S A Pic - Bel", "France: F MAO S F Bre - ENG",
+			"England: F ENG C A Pic - Bel", "England: A Bel - Pic",
+		},
+		dislodged: []string{"England: F ENG", "England: A Bel"},
+		board:     []string{"France: F ENG", "France: A Bel", "France: A Bur", "France: F MAO"},
+	},
+	{
+		name:  "6.G.5 Swapping with intent",
+		units: []string{"Italy: A Rom", "Italy: F TYS", "Turkey: A Apu", "Turkey: F ION"},
+		orders: []string{
+			"Italy: A Rom - Apu", "Italy: F TYS C A Apu - Rom",
+			"Turkey: A Apu - Rom", "Turkey: F ION C A Apu - Rom",
+		},
+		board: []string{"Italy: A Apu", "Italy: F TYS", "Turkey: A Rom", "Turkey: F ION"},
+	},
+	{
+		name: "6.G.6 Swapping with unintended intent",
+		units: []string{
+			"England: A Lvp", "England: F ENG", "Germany: A Edi", "France: F IRI", "France: F NTH",
+			"Russia: F NWG", "Russia: F NAO",
+		},
+		orders: []string{
+			"England: A Lvp - Edi", "England: F ENG C A Lvp - Edi",
+			"Germany: A Edi - Lvp",
+			"France: F IRI H", "France: F NTH H",
+			"Russia: F NWG C A Lvp - Edi", "Russia: F NAO C A Lvp - Edi",
+		},
+		board: []string{
+			"England: A Lvp", "England: F ENG", "Germany: A Edi", "France: F IRI", "France: F NTH",
+			"Russia: F NWG", "Russia: F NAO",
+		},
+	},
+	{
+		name:  "6.G.7 Swapping with illegal intent",
+		units: []string{"England: F SKA", "England: F Nwy", "Russia: A Swe", "Russia: F BOT"},
+		orders: []string{
+			"England: F SKA C A Swe - Nwy", "England: F Nwy - Swe",
+			"Russia: A Swe - Nwy", "Russia: F BOT C A Swe - Nwy",
+		},
+		board: []string{"England: F SKA", "England: F Nwy", "Russia: A Swe", "Russia: F BOT"},
+	},
+	{
+		name:   "6.G.8 Explicit convoy that isn't there",
+		units:  []string{"France: A Bel", "England: F NTH", "Germany: A Hol"},
+		orders: []string{"France: A Bel - Hol via Convoy", "England: F NTH - Hol", "Germany: A Hol - Kie"},
+		board:  []string{"France: A Bel", "England: F Hol", "Germany: A Kie"},
+	},
+	{
+		name:  "6.G.9 Swapped or dislodged?",
+		units: []string{"England: A Nwy", "England: F SKA", "England: F Fin", "Russia: A Swe"},
+		orders: []string{
+			"England: A Nwy - Swe", "England: F SKA C A Nwy - Swe", "England: F Fin S A Nwy - Swe",
+			"Russia: A Swe - Nwy",
+		},
+		board: []string{"England: A Swe", "England: F SKA", "England: F Fin", "Russia: A Nwy"},
+	},
+	{
+		name: "6.G.10 Swapped or an head to head battle?",
+		units: []string{
+			"England: A Nwy", "England: F Den", "England: F Fin", "Germany: F SKA",
+			"Russia: A Swe", "Russia: F BAR", "France: F NWG", "France: F NTH",
+		},
+		orders: []string{
+			"England: A Nwy - Swe via Convoy", "England: F Den S A Nwy - Swe", "England: F Fin S A Nwy - Swe",
+			"Germany: F SKA C A Nwy - Swe",
+			"Russia: A Swe - Nwy", "Russia: F BAR S A Swe - Nwy",
+			"France: F NWG - Nwy", "France: F NTH S F NWG - Nwy",
+		},
+		dislodged: []string{"Russia: A Swe"},
+		board: []string{
+			"England: A Swe", "England: F Den", "England: F Fin", "Germany: F SKA",
+			"Russia: F BAR", "France: F NWG", "France: F NTH",
+		},
+	},
+	{
+		name:  "6.G.11 A convoy to an adjacent place with a paradox",
+		units: []string{"England: F Nwy", "England: F NTH", "Russia: F SKA", "Russia: A Swe", "Russia: F BAR"},
+		orders: []string{
+			"England: F Nwy S F NTH - SKA", "England: F NTH - SKA",
+			"Russia: F SKA C A Swe - Nwy", "Russia: A Swe - Nwy", "Russia: F BAR S A Swe - Nwy",
+		},
+		dislodged: []string{"Russia: F SKA"},
+		board:     []string{"England: F Nwy", "England: F SKA", "Russia: A Swe", "Russia: F BAR"},
+	},
+	{
+		name: "6.G.12 Swapping two units with two convoys",
+		units: []string{
+			"England: A Lvp", "England: F NAO", "England: F NWG",
+			"Germany: A Edi", "Germany: F NTH", "Germany: F ENG", "Germany: F IRI",
+		},
+		orders: []string{
+			"England: A Lvp - Edi via Convoy", "England: F NAO C A Lvp - Edi", "England: F NWG C A Lvp - Edi",
+			"Germany: A Edi - Lvp via Convoy", "Germany: F NTH C A Edi - Lvp",
+			"Germany: F ENG C A Edi - Lvp", "Germany: F IRI C A Edi - Lvp",
+		},
+		board: []string{
+			"England: A Edi", "England: F NAO", "England: F NWG",
+			"Germany: A Lvp", "Germany: F NTH", "Germany: F ENG", "Germany: F IRI",
+		},
+	},
+	{
+		name:  "6.G.13 Support cut on attack on itself via convoy",
+		units: []string{"Austria: F ADR", "Austria: A Tri", "Italy: A Ven", "Italy: F Alb"},
+		orders: []string{
+			"Austria: F ADR C A Tri - Ven", "Austria: A Tri - Ven via Convoy",
+			"Italy: A Ven S F Alb - Tri", "Italy: F Alb - Tri",
+		},
+		dislodged: []string{"Austria: A Tri"},
+		board:     []string{"Austria: F ADR", "Italy: A Ven", "Italy: F Tri"},
+	},
+	{
+		name: "6.G.14 Bounce by convoy to adjacent place",
+		units: []string{
+			"England: A Nwy", "England: F Den", "England: F Fin", "France: F NWG", "France: F NTH",
+			"Germany: F SKA", "Russia: A Swe", "Russia: F BAR",
+		},
+		orders: []string{
+			"England: A Nwy - Swe", "England: F Den S A Nwy - Swe", "England: F Fin S A Nwy - Swe",
+			"France: F NWG - Nwy", "France: F NTH S F NWG - Nwy",
+			"Germany: F SKA C A Swe - Nwy",
+			"Russia: A Swe - Nwy via Convoy", "Russia: F BAR S A Swe - Nwy",
+		},
+		dislodged: []string{"Russia: A Swe"},
+		board: []string{
+			"England: A Swe", "England: F Den", "England: F Fin", "France: F NWG", "France: F NTH",
+			"Germany: F SKA", "Russia: F BAR",
+		},
+	},
+	{
+		name: "6.G.15 Bounce and dislodge with double convoy",
+		units: []string{
+			"England: F NTH", "England: A Hol", "England: A Yor", "England: A Lon", "France: F ENG", "France: A Bel",
+		},
+		orders: []string{
+			"England: F NTH C A Lon - Bel", "England: A Hol S A Lon - Bel",
+			"England: A Yor - Lon", "England: A Lon - Bel via Convoy",
+			"France: F ENG C A Bel - Lon", "France: A Bel - Lon via Convoy",
+		},
+		dislodged: []string{"France: A Bel"},
+		board:     []string{"England: F NTH", "England: A Hol", "England: A Yor", "England: A Bel", "France: F ENG"},
+	},
+	{
+		name: "6.G.16 The two unit in one area bug, moving by convoy",
+		units: []string{
+			"England: A Nwy", "England: A Den", "England: F BAL", "England: F NTH",
+			"Russia: A Swe", "Russia: F SKA", "Russia: F NWG",
+		},
+		orders: []string{
+			"England: A Nwy - Swe", "England: A Den S A Nwy - Swe", "England: F BAL S A Nwy - Swe",
+			"England: F NTH - Nwy",
+			"Russia: A Swe - Nwy via Convoy", "Russia: F SKA C A Swe - Nwy", "Russia: F NWG S A Swe - Nwy",
+		},
+		board: []string{
+			"England: A Swe", "England: A Den", "England: F BAL", "England: F NTH",
+			"Russia: A Nwy", "Russia: F SKA", "Russia: F NWG",
+		},
+	},
+	{
+		name: "6.G.17 The two unit in one area bug, moving over land",
+		units: []string{
+			"England: A Nwy", "England: A Den", "England: F BAL", "England: F SKA", "England: F NTH",
+			"Russia: A Swe", "Russia: F NWG",
+		},
+		orders: []string{
+			"England: A Nwy - Swe via Convoy", "England: A Den S A Nwy - Swe", "England: F BAL S A Nwy - Swe",
+			"England: F SKA C A Nwy - Swe", "England: F NTH - Nwy",
+			"Russia: A Swe - Nwy", "Russia: F NWG S A Swe - Nwy",
+		},
+		board: []string{
+			"England: A Swe", "England: A Den", "England: F BAL", "England: F SKA", "England: F NTH",
+			"Russia: A Nwy", "Russia: F NWG",
+		},
+	},
+	{
+		name: "6.G.18 The two unit in one area bug, with double convoy",
+		units: []string{
+			"England: F NTH", "England: A Hol", "England: A Yor", "England: A Lon", "England: A Ruh",
+			"France: F ENG", "France: A Bel", "France: A Wal",
+		},
+		orders: []string{
+			"England: F NTH C A Lon - Bel", "England: A Hol S A Lon - Bel", "England: A Yor - Lon",
+			"England: A Lon - Bel", "England: A Ruh S A Lon - Bel",
+			"France: F ENG C A Bel - Lon", "France: A Bel - Lon", "France: A Wal S A Bel - Lon",
+		},
+		board: []string{
+			"England: F NTH", "England: A Hol", "England: A Yor", "England: A Bel", "England: A Ruh",
+			"France: F ENG", "France: A Lon", "France: A Wal",
+		},
+	},
+	{
+		name: "6.G.19 Swapping with intent of unnecessary convoy",
+		skip: "convoy intent is only taken from a via Convoy order or from a convoy by the army's own fleets",
+	},
+	{
+		name: "6.G.20 Explicit convoy to adjacent province disrupted",
+		skip: "a move ordered via Convoy does not fall back to the land route when the convoy is disrupted",
+	},
+
+	// 6.H. Retreating
+	{
+		name:  "6.H.1 No supports during retreat",
+		units: []string{"Austria: F Tri", "Austria: A Ser", "Turkey: F Gre", "Italy: A Ven", "Italy: A Tyr", "Italy: F ION", "Italy: F AEG"},
+		orders: []string{
+			"Austria: F Tri H", "Austria: A Ser H",
+			"Turkey: F Gre H",
+			"Italy: A Ven S A Tyr - Tri", "Italy: A Tyr - Tri", "Italy: F ION - Gre", "Italy: F AEG S F ION - Gre",
+		},
+		dislodged: []string{"Austria: F Tri", "Turkey: F Gre"},
+		retreats:  []string{"Austria: F Tri R Alb", "!Austria: A Ser S F Tri - Alb", "Turkey: F Gre R Alb"},
+		board:     []string{"Austria: A Ser", "Italy: A Ven", "Italy: A Tri", "Italy: F Gre", "Italy: F AEG"},
+	},
+	{
+		name: "6.H.2 No supports from retreating unit",
+		units: []string{
+			"England: A Lvp", "England: F Yor", "England: F Nwy", "Germany: A Kie", "Germany: A Ruh",
+			"Russia: F Edi", "Russia: A Swe", "Russia: A Fin", "Russia: F Hol",
+		},
+		orders: []string{
+			"England: A Lvp - Edi", "England: F Yor S A Lvp - Edi", "England: F Nwy H",
+			"Germany: A Kie S A Ruh - Hol", "Germany: A Ruh - Hol",
+			"Russia: F Edi H", "Russia: A Swe S A Fin - Nwy", "Russia: A Fin - Nwy", "Russia: F Hol H",
+		},
+		dislodged: []string{"England: F Nwy", "Russia: F Edi", "Russia: F Hol"},
+		retreats:  []string{"England: F Nwy R NTH", "Russia: F Edi R NTH", "!Russia: F Hol S F Edi - NTH"},
+		board: []string{
+			"England: A Edi", "England: F Yor", "Germany: A Kie", "Germany: A Hol", "Russia: A Swe", "Russia: A Nwy",
+		},
+	},
+	{
+		name:      "6.H.3 No convoy during retreat",
+		units:     []string{"England: F NTH", "England: A Hol", "Germany: F Kie", "Germany: A Ruh"},
+		orders:    []string{"England: F NTH H", "England: A Hol H", "Germany: F Kie S A Ruh - Hol", "Germany: A Ruh - Hol"},
+		dislodged: []string{"England: A Hol"},
+		retreats:  []string{"!England: A Hol R Yor", "!England: F NTH C A Hol - Yor"},
+		board:     []string{"England: F NTH", "Germany: F Kie", "Germany: A Hol"},
+	},
+	{
+		name:      "6.H.4 No other moves during retreat",
+		units:     []string{"England: F NTH", "England: A Hol", "Germany: F Kie", "Germany: A Ruh"},
+		orders:    []string{"England: F NTH H", "England: A Hol H", "Germany: F Kie S A Ruh - Hol", "Germany: A Ruh - Hol"},
+		dislodged: []string{"England: A Hol"},
+		retreats:  []string{"England: A Hol R Bel", "!England: F NTH - NWG"},
+		board:     []string{"England: F NTH", "England: A Bel", "Germany: F Kie", "Germany: A Hol"},
+	},
+	{
+		name:      "6.H.5 A unit may not retreat to the area from which it is attacked",
+		units:     []string{"Russia: F Con", "Russia: F BLA", "Turkey: F Ank"},
+		orders:    []string{"Russia: F Con S F BLA - Ank", "Russia: F BLA - Ank", "Turkey: F Ank H"},
+		dislodged: []string{"Turkey: F Ank"},
+		retreats:  []string{"!Turkey: F Ank R BLA"},
+		board:     []string{"Russia: F Con", "Russia: F Ank"},
+	},
+	{
+		name:  "6.H.6 Unit may not retreat to a contested area",
+		units: []string{"Austria: A Bud", "Austria: A Tri", "Germany: A Mun", "Germany: A Sil", "Italy: A Vie"},
+		orders: []string{
+			"Austria: A Bud S A Tri - Vie", "Austria: A Tri - Vie",
+			"Germany: A Mun - Boh", "Germany: A Sil - Boh",
+			"Italy: A Vie H",
+		},
+		dislodged: []string{"Italy: A Vie"},
+		retreats:  []string{"!Italy: A Vie R Boh"},
+		board:     []string{"Austria: A Bud", "Austria: A Vie", "Germany: A Mun", "Germany: A Sil"},
+	},
+	{
+		name:  "6.H.7 Multiple retreat to same area will disband units",
+		units: []string{"Austria: A Bud", "Austria: A Tri", "Germany: A Mun", "Germany: A Sil", "Italy: A Vie", "Italy: A Boh"},
+		orders: []string{
+			"Austria: A Bud S A Tri - Vie", "Austria: A Tri - Vie",
+			"Germany: A Mun S A Sil - Boh", "Germany: A Sil - Boh",
+			"Italy: A Vie H", "Italy: A Boh H",
+		},
+		dislodged: []string{"Italy: A Vie", "Italy: A Boh"},
+		retreats:  []string{"Italy: A Boh R Tyr", "Italy: A Vie R Tyr"},
+		board:     []string{"Austria: A Bud", "Austria: A Vie", "Germany: A Mun", "Germany: A Boh"},
+	},
+	{
+		name: "6.H.8 Triple retreat to same area will disband units",
+		units: []string{
+			"England: A Lvp", "England: F Yor", "England: F Nwy", "Germany: A Kie", "Germany: A Ruh",
+			"Russia: F Edi", "Russia: A Swe", "Russia: A Fin", "Russia: F Hol",
+		},
+		orders: []string{
+			"England: A Lvp - Edi", "England: F Yor S A Lvp - Edi", "England: F Nwy H",
+			"Germany: A Kie S A Ruh - Hol", "Germany: A Ruh - Hol",
+			"Russia: F Edi H", "Russia: A Swe S A Fin - Nwy", "Russia: A Fin - Nwy", "Russia: F Hol H",
+		},
+		dislodged: []string{"England: F Nwy", "Russia: F Edi", "Russia: F Hol"},
+		retreats:  []string{"England: F Nwy R NTH", "Russia: F Edi R NTH", "Russia: F Hol R NTH"},
+		board: []string{
+			"England: A Edi", "England: F Yor", "Germany: A Kie", "Germany: A Hol", "Russia: A Swe", "Russia: A Nwy",
+		},
+	},
+	{
+		name: "6.H.9 Dislodged unit will not make attackers area contested",
+		units: []string{
+			"England: F HEL", "England: F Den", "Germany: A Ber", "Germany: F Kie", "Germany: A Sil", "Russia: A Pru",
+		},
+		orders: []string{
+			"England: F HEL - Kie", "England: F Den S F HEL - Kie",
+			"Germany: A Ber - Pru", "Germany: F Kie H", "Germany: A Sil S A Ber - Pru",
+			"Russia: A Pru - Ber",
+		},
+		dislodged: []string{"Germany: F Kie", "Russia: A Pru"},
+		retreats:  []string{"Germany: F Kie R Ber"},
+		board:     []string{"England: F Kie", "England: F Den", "Germany: A Pru", "Germany: F Ber", "Germany: A Sil"},
+	},
+	{
+		name: "6.H.10 Not retreating to attacker does not mean contested",
+		units: []string{
+			"England: A Kie", "Germany: A Ber", "Germany: A Mun", "Germany: A Pru", "Russia: A War", "Russia: A Sil",
+		},
+		orders: []string{
+			"England: A Kie H",
+			"Germany: A Ber - Kie", "Germany: A Mun S A Ber - Kie", "Germany: A Pru H",
+			"Russia: A War - Pru", "Russia: A Sil S A War - Pru",
+		},
+		dislodged: []string{"England: A Kie", "Germany: A Pru"},
+		retreats:  []string{"!England: A Kie R Ber", "Germany: A Pru R Ber"},
+		board:     []string{"Germany: A Kie", "Germany: A Mun", "Germany: A Ber", "Russia: A Pru", "Russia: A Sil"},
+	},
+	{
+		name: "6.H.11 Retreat when dislodged by adjacent convoy",
+		units: []string{
+			"France: A Gas", "France: A Bur", "France: F MAO", "France: F WES", "France: F LYO", "Italy: A Mar",
+		},
+		orders: []string{
+			"France: A Gas - Mar via Convoy", "France: A Bur S A Gas - Mar",
+			"France: F MAO C A Gas - Mar", "France: F WES C A Gas - Mar", "France: F LYO C A Gas - Mar",
+			"Italy: A Mar H",
+		},
+		dislodged: []string{"Italy: A Mar"},
+		retreats:  []string{"Italy: A Mar R Gas"},
+		board: []string{
+			"France: A Mar", "France: A Bur", "France: F MAO", "France: F WES", "France: F LYO", "Italy: A Gas",
+		},
+	},
+	{
+		name: "6.H.12 Retreat when dislodged by adjacent convoy while trying to do the same",
+		units: []string{
+			"England: A Lvp", "England: F IRI", "England: F ENG", "England: F NTH",
+			"France: F Bre", "France: F MAO",
+			"Russia: A Edi", "Russia: F NWG", "Russia: F NAO", "Russia: A Cly",
+		},
+		orders: []string{
+			"England: A Lvp - Edi via Convoy", "England: F IRI C A Lvp - Edi", "England: F ENG C A Lvp - Edi", "England: F NTH C A Lvp - Edi",
+			"France: F Bre - ENG", "France: F MAO S F Bre - ENG",
+			"Russia: A Edi - Lvp via Convoy", "Russia: F NWG C A Edi - Lvp", "Russia: F NAO C A Edi - Lvp", "Russia: A Cly S A Edi - Lvp",
+		},
+		dislodged: []string{"England: A Lvp", "England: F ENG"},
+		retreats:  []string{"England: A Lvp R Edi"},
+		board: []string{
+			"England: A Edi", "England: F IRI", "England: F NTH",
+			"France: F ENG", "France: F MAO",
+			"Russia: A Lvp", "Russia: F NWG", "Russia: F NAO", "Russia: A Cly",
+		},
+	},
+	{
+		name:  "6.H.13 No retreat with convoy in main phase",
+		units: []string{"England: A Pic", "England: F ENG", "France: A Par", "France: A Bre"},
+		orders: []string{
+			"England: A Pic H", "England: F ENG C A Pic - Lon",
+			"France: A Par - Pic", "France: A Bre S A Par - Pic",
+		},
+		dislodged: []string{"England: A Pic"},
+		retreats:  []string{"!England: A Pic R Lon"},
+		board:     []string{"England: F ENG", "France: A Pic", "France: A Bre"},
+	},
+	{
+		name: "6.H.14 No retreat with support in main phase",
+		units: []string{
+			"England: A Pic", "England: F ENG", "France: A Par", "France: A Bre", "France: A Bur",
+			"Germany: A Mun", "Germany: A Mar",
+		},
+		orders: []string{
+			"England: A Pic H", "England: F ENG S A Pic - Bel",
+			"France: A Par - Pic", "France: A Bre S A Par - Pic", "France: A Bur H",
+			"Germany: A Mun S A Mar - Bur", "Germany: A Mar - Bur",
+		},
+		dislodged: []string{"England: A Pic", "France: A Bur"},
+		retreats:  []string{"England: A Pic R Bel", "France: A Bur R Bel"},
+		board:     []string{"England: F ENG", "France: A Pic", "France: A Bre", "Germany: A Mun", "Germany: A Bur"},
+	},
+	{
+		name:      "6.H.15 No coastal crawl in retreat",
+		units:     []string{"England: F Por", "France: F Spa/sc", "France: F MAO"},
+		orders:    []string{"England: F Por H", "France: F Spa/sc - Por", "France: F MAO S F Spa/sc - Por"},
+		dislodged: []string{"England: F Por"},
+		retreats:  []string{"!England: F Por R Spa/nc"},
+		board:     []string{"France: F Por", "France: F MAO"},
+	},
+	{
+		name:  "6.H.16 Contested for both coasts",
+		units: []string{"France: F MAO", "France: F Gas", "France: F WES", "Italy: F Tun", "Italy: F TYS"},
+		orders: []string{
+			"France: F MAO - Spa/nc", "France: F Gas - Spa/nc", "France: F WES H",
+			"Italy: F Tun S F TYS - WES", "Italy: F TYS - WES",
+		},
+		dislodged: []string{"France: F WES"},
+		retreats:  []string{"!France: F WES R Spa/sc"},
+		board:     []string{"France: F MAO", "France: F Gas", "Italy: F Tun", "Italy: F WES"},
+	},
+
+	// 6.I. Building
+	{
+		name:    "6.I.1 Too many build orders",
+		phase:   BuildPhase,
+		units:   []string{"Germany: A Ruh", "Germany: A Hol"},
+		centers: []string{"Germany: Ber Kie Hol", "Russia: War"},
+		orders:  []string{"!Germany: A War B", "Germany: A Kie B", "!Germany: A Mun B"},
+		board:   []string{"Germany: A Ruh", "Germany: A Hol", "Germany: A Kie"},
+	},
+	{
+		name:    "6.I.2 Fleets can not be built in land areas",
+		phase:   BuildPhase,
+		centers: []string{"Russia: Mos"},
+		orders:  []string{"!Russia: F Mos B"},
+		board:   []string{},
+	},
+	{
+		name:    "6.I.3 Supply center must be empty for building",
+		phase:   BuildPhase,
+		units:   []string{"Germany: A Ber"},
+		centers: []string{"Germany: Ber Mun"},
+		orders:  []string{"!Germany: A Ber B"},
+		board:   []string{"Germany: A Ber"},
+	},
+	{
+		name:    "6.I.4 Both coasts must be specified",
+		phase:   BuildPhase,
+		centers: []string{"Russia: Stp"},
+		orders:  []string{"!Russia: F Stp B"},
+		board:   []string{},
+	},
+	{
+		name:    "6.I.5 Building in home supply center that is not owned",
+		phase:   BuildPhase,
+		centers: []string{"Germany: Kie", "Russia: Ber"},
+		orders:  []string{"!Germany: A Ber B"},
+		board:   []string{},
+	},
+	{
+		name:    "6.I.6 Building in owned supply center that is not a home supply center",
+		phase:   BuildPhase,
+		centers: []string{"Germany: War"},
+		orders:  []string{"!Germany: A War B"},
+		board:   []string{},
+	},
+	{
+		name:    "6.I.7 Only one build in a home supply center",
+		phase:   BuildPhase,
+		centers: []string{"Russia: Mos War"},
+		orders:  []string{"Russia: A Mos B", "Russia: A Mos B"},
+		board:   []string{"Russia: A Mos"},
+	},
+
+	// 6.J. Civil disorder and disbands
+	{
+		name:    "6.J.1 Too many remove orders",
+		phase:   BuildPhase,
+		units:   []string{"France: A Par", "France: A Pic"},
+		centers: []string{"France: Par"},
+		orders:  []string{"!France: F LYO D", "France: A Pic D", "!France: A Par D"},
+		board:   []string{"France: A Par"},
+	},
+	{
+		name:    "6.J.2 Removing the same unit twice",
+		phase:   BuildPhase,
+		units:   []string{"France: A Par", "France: A Pic", "France: F Bre"},
+		centers: []string{"France: Par"},
+		orders:  []string{"France: A Par D", "France: A Par D"},
+		board:   []string{"France: F Bre"},
+	},
+	{
+		name:    "6.J.3 Civil disorder two armies with different distance",
+		phase:   BuildPhase,
+		units:   []string{"Russia: A Lvn", "Russia: A Swe"},
+		centers: []string{"Russia: Swe"},
+		board:   []string{"Russia: A Lvn"},
+	},
+	{
+		name:    "6.J.4 Civil disorder two armies with equal distance",
+		phase:   BuildPhase,
+		units:   []string{"Russia: A Lvn", "Russia: A Ukr"},
+		centers: []string{"Russia: Mos"},
+		board:   []string{"Russia: A Ukr"},
+	},
+	{
+		name:    "6.J.5 Civil disorder two fleets with different distance",
+		phase:   BuildPhase,
+		units:   []string{"Russia: F BOT", "Russia: F NTH"},
+		centers: []string{"Russia: Mos"},
+		board:   []string{"Russia: F BOT"},
+	},
+	{
+		name:    "6.J.6 Civil disorder two fleets with equal distance",
+		phase:   BuildPhase,
+		units:   []string{"Russia: F BOT", "Russia: F BLA"},
+		centers: []string{"Russia: Mos"},
+		board:   []string{"Russia: F BOT"},
+	},
+	{
+		name:    "6.J.7 Civil disorder two fleets and army with equal distance",
+		phase:   BuildPhase,
+		units:   []string{"Russia: A Lvn", "Russia: F BOT", "Russia: F BLA"},
+		centers: []string{"Russia: Mos"},
+		board:   []string{"Russia: A Lvn"},
+	},
+	{
+		name:    "6.J.8 Civil disorder a fleet with shorter distance than the army",
+		phase:   BuildPhase,
+		units:   []string{"Russia: A Boh", "Russia: F BOT"},
+		centers: []string{"Russia: Mos"},
+		board:   []string{"Russia: F BOT"},
+	},
+	{
+		name: "6.J.9 Civil disorder must be counted from both coasts",
+		skip: "distances are counted over all adjacencies of a province and not along the coasts a fleet can reach",
+	},
+	{
+		name: "6.J.10 Civil disorder counting convoying distance",
+		skip: "distances are counted over all adjacencies and not along the routes an army can be convoyed",
+	},
+	{
+		name: "6.J.11 Distance to owned supply center",
+		skip: "distances are counted to every home center, whether it is owned or not",
+	},
+}
+
+func TestDATC(t *testing.T) {
+	for _, c := range datcCases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.skip != "" {
+				t.Skip(c.skip)
+			}
+			runDATCCase(t, c)
+		})
+	}
+}
+
+func runDATCCase(t *testing.T, c datcCase) {
+	s := setupAdjudicationState()
+	standard, _ := InitializeNewGame(StandardVariant)
+	for i, country := range s.Countries {
+		country.HomeCenters = standard.Countries[i].HomeCenters
+	}
+	if c.phase == BuildPhase {
+		s.Turn = Winter
+		s.Phase = BuildPhase
+	}
+
+	for _, line := range c.units {
+		country, unitType, location := parseDATCUnit(t, s, line)
+		_, err := s.World.AddUnit(country, unitType, location)
+		if !assert.NoError(t, err, line) {
+			return
+		}
+	}
+	for _, line := range c.centers {
+		name, keys, _ := strings.Cut(line, ": ")
+		for _, key := range strings.Fields(keys) {
+			assert.NoError(t, s.SetOwner(key, name))
+		}
+	}
+
+	submitDATCOrders(t, s, c.orders)
+	adjudicate(t, s)
+
+	if c.phase == OrderPhase {
+		dislodged := []string{}
+		for _, d := range s.Dislodged {
+			dislodged = append(dislodged, fmt.Sprintf("%s: %s %s", d.Unit.Country.Name, d.Unit.Type, location(d.Position.Key, d.Unit.Coast)))
+		}
+		assert.ElementsMatch(t, orEmpty(c.dislodged), dislodged, "dislodged units")
+	}
+
+	if len(c.retreats) > 0 {
+		submitDATCOrders(t, s, c.retreats)
+		adjudicate(t, s)
+	}
+
+	assert.ElementsMatch(t, orEmpty(c.board), datcBoard(s), "units on the board")
+}
+
+func orEmpty(lines []string) []string {
+	if lines == nil {
+		return []string{}
+	}
+	return lines
+}
+
+func datcBoard(s *State) []string {
+	board := []string{}
+	for _, key := range sortedProvinceKeys(s.World) {
+		if unit := s.World.Provinces[key].Unit; unit != nil {
+			board = append(board, fmt.Sprintf("%s: %s %s", unit.Country.Name, unit.Type, location(key, unit.Coast)))
+		}
+	}
+	sort.Strings(board)
+	return board
+}
+
+func parseDATCUnit(t *testing.T, s *State, line string) (*Country, UnitType, string) {
+	name, unit, _ := strings.Cut(line, ": ")
+	country, err := s.GetCountry(name)
+	assert.NoError(t, err, line)
+
+	fields := strings.Fields(unit)
+	unitType := Army
+	if fields[0] == "F" {
+		unitType = Fleet
+	}
+	return country, unitType, fields[1]
+}
+
+func submitDATCOrders(t *testing.T, s *State, lines []string) {
+	for _, line := range lines {
+		illegal := strings.HasPrefix(line, "!")
+		err := submitDATCOrder(s, strings.TrimPrefix(line, "!"))
+		if illegal {
+			assert.Error(t, err, "%s should be rejected", line)
+		} else {
+			assert.NoError(t, err, line)
+		}
+	}
+}
+
+// submitDATCOrder parses and adds an order written like
+// "England: F NTH C A Lon - Bel".
+func submitDATCOrder(s *State, line string) error {
+	country, text, _ := strings.Cut(line, ": ")
+	order, err := s.ParseOrder(country, text)
+	if err != nil {
+		return err
+	}
+	return s.AddOrder(country, order)
+}
@@ -0,0 +1,397 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Sentinel errors of the engine. The structured errors below match them with
+// errors.Is, so callers can check for a kind of error without inspecting its
+// details.
+var (
+	ErrProvinceNotFound   = errors.New("Province not found")
+	ErrCountryNotFound    = errors.New("Country does not exist")
+	ErrCoastNotFound      = errors.New("Coast not found")
+	ErrProvinceOccupied   = errors.New("Province already occupied")
+	ErrNilProvince        = errors.New("input is nil")
+	ErrUnsupportedTurn    = errors.New("Unsupported Turn")
+	ErrUnsupportedOrder   = errors.New("Order type is not supported")
+	ErrWrongPhase         = errors.New("Order is not allowed in this phase")
+	ErrNoUnit             = errors.New("No unit to order")
+	ErrNoDislodgedUnit    = errors.New("No dislodged unit to order")
+	ErrNotYourUnit        = errors.New("Unit belongs to another country")
+	ErrNotAdjacent        = errors.New("Destination cannot be reached")
+	ErrCoastRequired      = errors.New("Coast has to be specified")
+	ErrSupportUnreachable = errors.New("Supporting unit cannot reach the destination")
+	ErrInvalidConvoy      = errors.New("Only fleets at sea can convoy")
+	ErrFleetConvoyed      = errors.New("Only armies can be convoyed")
+	ErrNotHomeCenter      = errors.New("Not a home center")
+	ErrNotOwned           = errors.New("Supply center is not owned")
+	ErrNotCoastal         = errors.New("Fleets have to be built on the coast")
+	ErrTooManyAdjustments = errors.New("Too many builds or disbands")
+	ErrInvalidNotation    = errors.New("Order cannot be parsed")
+	ErrUnexpectedToken    = errors.New("Unexpected word")
+	ErrIncompleteOrder    = errors.New("Order is incomplete")
+	ErrWrongUnitType      = errors.New("Unit type does not match the unit")
+	ErrPhaseNotFound      = errors.New("Phase not found")
+	ErrInvalidPhaseName   = errors.New("Invalid phase name")
+	ErrUnknownValue       = errors.New("Unknown value")
+	ErrInvalidMap         = errors.New("Map is invalid")
+	ErrMapNotFound        = errors.New("Map not found")
+	ErrVariantNotFound    = errors.New("Variant not found")
+	ErrVariantExists      = errors.New("Variant already exists")
+	ErrInvalidVariant     = errors.New("Variant is invalid")
+	ErrVictoryCenters     = errors.New("Victory requires more centers than the map has")
+	ErrGameFinished       = errors.New("Game is finished")
+	ErrEliminated         = errors.New("Country is eliminated")
+	ErrNoDrawProposal     = errors.New("No draw has been proposed")
+	ErrNotSupplyCenter    = errors.New("Province is not a supply center")
+	ErrOrdersLocked       = errors.New("Orders are locked")
+	ErrNoExtensions       = errors.New("No extensions left for this phase")
+	ErrAttackerOrigin     = errors.New("Cannot retreat to the origin of the attack")
+	ErrStandoff           = errors.New("Cannot retreat to a province left empty by a standoff")
+)
+
+type ProvinceNotFoundError struct {
+	Key string
+}
+
+func (e *ProvinceNotFoundError) Error() string {
+	return fmt.Sprintf("Province '%s' not found", e.Key)
+}
+
+func (e *ProvinceNotFoundError) Is(target error) bool {
+	return target == ErrProvinceNotFound
+}
+
+type CountryNotFoundError struct {
+	Name string
+}
+
+func (e *CountryNotFoundError) Error() string {
+	return fmt.Sprintf("Country '%s' does not exist", e.Name)
+}
+
+func (e *CountryNotFoundError) Is(target error) bool {
+	return target == ErrCountryNotFound
+}
+
+type CoastNotFoundError struct {
+	Key   string
+	Coast Coast
+}
+
+func (e *CoastNotFoundError) Error() string {
+	return fmt.Sprintf("Province '%s' has no coast '%s'", e.Key, e.Coast)
+}
+
+func (e *CoastNotFoundError) Is(target error) bool {
+	return target == ErrCoastNotFound
+}
+
+// CoastRequiredError is returned when a fleet is placed in a province with
+// several coasts without naming one of them.
+type CoastRequiredError struct {
+	Key string
+}
+
+func (e *CoastRequiredError) Error() string {
+	return fmt.Sprintf("Fleets in '%s' need a coast", e.Key)
+}
+
+func (e *CoastRequiredError) Is(target error) bool {
+	return target == ErrCoastRequired
+}
+
+type PhaseNotFoundError struct {
+	Name string
+}
+
+func (e *PhaseNotFoundError) Error() string {
+	return fmt.Sprintf("Phase '%s' not found", e.Name)
+}
+
+func (e *PhaseNotFoundError) Is(target error) bool {
+	return target == ErrPhaseNotFound
+}
+
+type InvalidPhaseNameError struct {
+	Name string
+}
+
+func (e *InvalidPhaseNameError) Error() string {
+	return fmt.Sprintf("Invalid phase name '%s'", e.Name)
+}
+
+func (e *InvalidPhaseNameError) Is(target error) bool {
+	return target == ErrInvalidPhaseName
+}
+
+// UnknownValueError is returned when a saved state names a unit type, turn,
+// phase or similar value that does not exist.
+type UnknownValueError struct {
+	Kind  string
+	Value string
+}
+
+func (e *UnknownValueError) Error() string {
+	return fmt.Sprintf("Unknown %s '%s'", e.Kind, e.Value)
+}
+
+func (e *UnknownValueError) Is(target error) bool {
+	return target == ErrUnknownValue
+}
+
+// InvalidMapError lists every problem found while validating a map.
+type InvalidMapError struct {
+	Problems []string
+}
+
+func (e *InvalidMapError) Error() string {
+	return fmt.Sprintf("Map is invalid: %s", strings.Join(e.Problems, "; "))
+}
+
+func (e *InvalidMapError) Is(target error) bool {
+	return target == ErrInvalidMap
+}
+
+type MapNotFoundError struct {
+	Name string
+}
+
+func (e *MapNotFoundError) Error() string {
+	return fmt.Sprintf("Map '%s' not found", e.Name)
+}
+
+func (e *MapNotFoundError) Is(target error) bool {
+	return target == ErrMapNotFound
+}
+
+type VariantNotFoundError struct {
+	Name string
+}
+
+func (e *VariantNotFoundError) Error() string {
+	return fmt.Sprintf("Variant '%s' not found", e.Name)
+}
+
+func (e *VariantNotFoundError) Is(target error) bool {
+	return target == ErrVariantNotFound
+}
+
+type VariantExistsError struct {
+	Name string
+}
+
+func (e *VariantExistsError) Error() string {
+	return fmt.Sprintf("Variant '%s' already exists", e.Name)
+}
+
+func (e *VariantExistsError) Is(target error) bool {
+	return target == ErrVariantExists
+}
+
+// InvalidVariantError is returned when a variant cannot be registered. The
+// reason is an InvalidMapError if the map of the variant is inconsistent.
+type InvalidVariantError struct {
+	Name   string
+	Reason error
+}
+
+func (e *InvalidVariantError) Error() string {
+	return fmt.Sprintf("Variant '%s' is invalid: %s", e.Name, e.Reason)
+}
+
+func (e *InvalidVariantError) Is(target error) bool {
+	return target == ErrInvalidVariant
+}
+
+func (e *InvalidVariantError) Unwrap() error {
+	return e.Reason
+}
+
+type TooManyVictoryCentersError struct {
+	Required int
+	Centers  int
+}
+
+func (e *TooManyVictoryCentersError) Error() string {
+	return fmt.Sprintf("Victory requires %d of %d supply centers", e.Required, e.Centers)
+}
+
+func (e *TooManyVictoryCentersError) Is(target error) bool {
+	return target == ErrVictoryCenters
+}
+
+type CountryEliminatedError struct {
+	Name string
+}
+
+func (e *CountryEliminatedError) Error() string {
+	return fmt.Sprintf("Country '%s' is eliminated", e.Name)
+}
+
+func (e *CountryEliminatedError) Is(target error) bool {
+	return target == ErrEliminated
+}
+
+type NotSupplyCenterError struct {
+	Key string
+}
+
+func (e *NotSupplyCenterError) Error() string {
+	return fmt.Sprintf("Province '%s' is not a supply center", e.Key)
+}
+
+func (e *NotSupplyCenterError) Is(target error) bool {
+	return target == ErrNotSupplyCenter
+}
+
+type OrdersLockedError struct {
+	Country string
+}
+
+func (e *OrdersLockedError) Error() string {
+	return fmt.Sprintf("Orders of %s are locked until it is no longer ready", e.Country)
+}
+
+func (e *OrdersLockedError) Is(target error) bool {
+	return target == ErrOrdersLocked
+}
+
+type WrongPhaseError struct {
+	Phase Phase
+}
+
+func (e *WrongPhaseError) Error() string {
+	return fmt.Sprintf("Order is not allowed in the %s phase", e.Phase)
+}
+
+func (e *WrongPhaseError) Is(target error) bool {
+	return target == ErrWrongPhase
+}
+
+type NoUnitError struct {
+	Key string
+}
+
+func (e *NoUnitError) Error() string {
+	return fmt.Sprintf("No unit in '%s'", e.Key)
+}
+
+func (e *NoUnitError) Is(target error) bool {
+	return target == ErrNoUnit
+}
+
+type NoDislodgedUnitError struct {
+	Key string
+}
+
+func (e *NoDislodgedUnitError) Error() string {
+	return fmt.Sprintf("No dislodged unit in '%s'", e.Key)
+}
+
+func (e *NoDislodgedUnitError) Is(target error) bool {
+	return target == ErrNoDislodgedUnit
+}
+
+type NotYourUnitError struct {
+	Country string
+	Owner   string
+}
+
+func (e *NotYourUnitError) Error() string {
+	return fmt.Sprintf("%s cannot add order to unit of %s", e.Country, e.Owner)
+}
+
+func (e *NotYourUnitError) Is(target error) bool {
+	return target == ErrNotYourUnit
+}
+
+// IllegalMoveError is returned for an order the ordered unit cannot carry
+// out. The reason is one of the sentinel errors and is unwrapped by
+// errors.Is.
+type IllegalMoveError struct {
+	Order  Order
+	Reason error
+}
+
+func (e *IllegalMoveError) Error() string {
+	return fmt.Sprintf("Illegal order '%s': %s", e.Order, e.Reason)
+}
+
+func (e *IllegalMoveError) Unwrap() error {
+	return e.Reason
+}
+
+// IllegalBuildError is returned for a unit that cannot be built in a
+// province. The reason is one of the sentinel errors.
+type IllegalBuildError struct {
+	Country string
+	Key     string
+	Reason  error
+}
+
+func (e *IllegalBuildError) Error() string {
+	return fmt.Sprintf("%s cannot build in '%s': %s", e.Country, e.Key, e.Reason)
+}
+
+func (e *IllegalBuildError) Unwrap() error {
+	return e.Reason
+}
+
+// AdjustmentLimitError is returned when a country orders more builds or
+// disbands than its number of supply centers allows.
+type AdjustmentLimitError struct {
+	Country string
+	Limit   int
+	Build   bool
+}
+
+func (e *AdjustmentLimitError) Error() string {
+	if e.Build {
+		return fmt.Sprintf("%s cannot build more than %d units", e.Country, e.Limit)
+	}
+	return fmt.Sprintf("%s cannot disband more than %d units", e.Country, e.Limit)
+}
+
+func (e *AdjustmentLimitError) Is(target error) bool {
+	return target == ErrTooManyAdjustments
+}
+
+type UnsupportedOrderError struct {
+	Order Order
+}
+
+func (e *UnsupportedOrderError) Error() string {
+	return fmt.Sprintf("Type %T is not supported", e.Order)
+}
+
+func (e *UnsupportedOrderError) Is(target error) bool {
+	return target == ErrUnsupportedOrder
+}
+
+// ParseError is returned for an order in standard notation that cannot be
+// parsed. It points at the offending token of the input.
+type ParseError struct {
+	Input  string
+	Token  string
+	Offset int
+	Reason error
+}
+
+func (e *ParseError) Error() string {
+	if e.Token == "" {
+		return fmt.Sprintf("Cannot parse '%s': %s", e.Input, e.Reason)
+	}
+	return fmt.Sprintf("Cannot parse '%s' at '%s' (position %d): %s", e.Input, e.Token, e.Offset, e.Reason)
+}
+
+func (e *ParseError) Is(target error) bool {
+	return target == ErrInvalidNotation
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Reason
+}
@@ -0,0 +1,64 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvinceNotFoundError(t *testing.T) {
+	s := setupAdjudicationState()
+
+	_, err := s.World.GetProvince("PAR")
+	assert.EqualError(t, err, "Province 'PAR' not found")
+	assert.ErrorIs(t, err, ErrProvinceNotFound)
+
+	err = s.AddHoldOrder("France", "PAR")
+	var notFound *ProvinceNotFoundError
+	if assert.True(t, errors.As(err, &notFound)) {
+		assert.Equal(t, "PAR", notFound.Key)
+	}
+}
+
+func TestCountryNotFoundError(t *testing.T) {
+	s := setupAdjudicationState()
+
+	err := s.AddHoldOrder("Spain", "Par")
+	assert.EqualError(t, err, "Country 'Spain' does not exist")
+	assert.ErrorIs(t, err, ErrCountryNotFound)
+}
+
+func TestNotYourUnitError(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"Germany", Army, "Mun"})
+
+	err := s.AddMoveOrder("France", "Mun", "Bur")
+	assert.EqualError(t, err, "France cannot add order to unit of Germany")
+	assert.ErrorIs(t, err, ErrNotYourUnit)
+
+	var notYours *NotYourUnitError
+	if assert.True(t, errors.As(err, &notYours)) {
+		assert.Equal(t, "France", notYours.Country)
+		assert.Equal(t, "Germany", notYours.Owner)
+	}
+}
+
+func TestIllegalMoveError(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"France", Army, "Par"})
+
+	err := s.AddMoveOrder("France", "Par", "Mun")
+	assert.EqualError(t, err, "Illegal order 'A Par - Mun': Destination cannot be reached")
+	assert.ErrorIs(t, err, ErrNotAdjacent)
+
+	var illegal *IllegalMoveError
+	if assert.True(t, errors.As(err, &illegal)) {
+		assert.Equal(t, s.World.Provinces["Par"], illegal.Order.GetPosition())
+		assert.Equal(t, ErrNotAdjacent, illegal.Reason)
+	}
+}
+
+func TestAdjustmentLimitError(t *testing.T) {
+	err := &AdjustmentLimitError{Country: "Austria", Limit: 2}
+	assert.EqualError(t, err, "Austria cannot disband more than 2 units")
+	assert.ErrorIs(t, err, ErrTooManyAdjustments)
+}
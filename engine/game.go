@@ -0,0 +1,253 @@
+package engine
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Game wraps a State and records every adjudicated phase, so earlier phases
+// can be reviewed and the whole game can be replayed. Draws have to be voted
+// on through the Game to be recorded.
+type Game struct {
+	State   *State
+	History []*PhaseRecord
+	// votes are the draw votes of the current phase.
+	votes []DrawVote
+}
+
+// PhaseRecord holds what happened in one phase: the positions before it, the
+// submitted orders and draw votes, the results and the positions after it. A
+// phase that ended in an agreed draw was not adjudicated and has no results.
+type PhaseRecord struct {
+	Name    string
+	Before  *Snapshot
+	Orders  []SubmittedOrder
+	Draws   []DrawVote
+	Results []*OrderResult
+	After   *Snapshot
+}
+
+// DrawVoteKind tells whether a country proposed, accepted or rejected a draw.
+type DrawVoteKind int8
+
+const (
+	DrawProposed DrawVoteKind = iota
+	DrawAccepted
+	DrawRejected
+)
+
+func (k DrawVoteKind) String() string {
+	switch k {
+	case DrawProposed:
+		return "Proposed"
+	case DrawAccepted:
+		return "Accepted"
+	case DrawRejected:
+		return "Rejected"
+	}
+	return ""
+}
+
+// DrawVote is a draw vote of a country. A proposal lists the members of the
+// draw, or none for a draw between all survivors.
+type DrawVote struct {
+	Country string
+	Kind    DrawVoteKind
+	Members []string
+}
+
+// SubmittedOrder is an order of a country in standard notation.
+type SubmittedOrder struct {
+	Country string
+	Order   string
+}
+
+// UnitPosition is a unit on the board, located like "Stp/sc".
+type UnitPosition struct {
+	Country  string   `json:"country"`
+	Type     UnitType `json:"type"`
+	Location string   `json:"location"`
+}
+
+func (u UnitPosition) String() string {
+	return fmt.Sprintf("%s: %s %s", u.Country, u.Type, u.Location)
+}
+
+// Snapshot is a copy of the positions on the board, which does not change
+// when the state moves on. Supply centers map to the name of their owner.
+type Snapshot struct {
+	Units         []UnitPosition
+	Dislodged     []UnitPosition
+	SupplyCenters map[string]string
+}
+
+// NewGame starts recording the given state.
+func NewGame(state *State) *Game {
+	return &Game{State: state, History: []*PhaseRecord{}}
+}
+
+// Adjudicate adjudicates the current phase of the state and records it.
+func (g *Game) Adjudicate() (*Report, error) {
+	record := &PhaseRecord{
+		Name:   g.State.PhaseName(),
+		Before: g.State.Snapshot(),
+		Orders: g.State.submittedOrders(),
+	}
+
+	report, err := g.State.Adjudicate()
+	if err != nil {
+		return nil, err
+	}
+
+	record.Draws, g.votes = g.votes, nil
+	record.Results = report.Results
+	record.After = g.State.Snapshot()
+	g.History = append(g.History, record)
+
+	return report, nil
+}
+
+// ProposeDraw proposes a draw like State.ProposeDraw and records the vote.
+func (g *Game) ProposeDraw(country string, members ...string) error {
+	return g.vote(DrawVote{Country: country, Kind: DrawProposed, Members: members}, func() error {
+		return g.State.ProposeDraw(country, members...)
+	})
+}
+
+// AcceptDraw accepts the proposed draw like State.AcceptDraw and records the
+// vote.
+func (g *Game) AcceptDraw(country string) error {
+	return g.vote(DrawVote{Country: country, Kind: DrawAccepted}, func() error {
+		return g.State.AcceptDraw(country)
+	})
+}
+
+// RejectDraw rejects the proposed draw like State.RejectDraw and records the
+// vote.
+func (g *Game) RejectDraw(country string) error {
+	return g.vote(DrawVote{Country: country, Kind: DrawRejected}, func() error {
+		return g.State.RejectDraw(country)
+	})
+}
+
+// vote casts a draw vote and records it with the current phase. A vote that
+// ends the game in a draw also ends the phase, which is then recorded
+// without being adjudicated.
+func (g *Game) vote(vote DrawVote, cast func() error) error {
+	orders := g.State.submittedOrders()
+	err := cast()
+	if err != nil {
+		return err
+	}
+
+	g.votes = append(g.votes, vote)
+	if g.State.Finished() {
+		g.History = append(g.History, &PhaseRecord{
+			Name:   g.State.PhaseName(),
+			Before: g.State.Snapshot(),
+			Orders: orders,
+			Draws:  g.votes,
+			After:  g.State.Snapshot(),
+		})
+		g.votes = nil
+	}
+	return nil
+}
+
+func (g *Game) replayVote(vote DrawVote) error {
+	switch vote.Kind {
+	case DrawProposed:
+		return g.ProposeDraw(vote.Country, vote.Members...)
+	case DrawAccepted:
+		return g.AcceptDraw(vote.Country)
+	}
+	return g.RejectDraw(vote.Country)
+}
+
+// Phase returns the record of an adjudicated phase by its canonical name,
+// e.g. "F1901R".
+func (g *Game) Phase(name string) (*PhaseRecord, error) {
+	for _, record := range g.History {
+		if record.Name == name {
+			return record, nil
+		}
+	}
+	return nil, &PhaseNotFoundError{Name: name}
+}
+
+// Replay plays the recorded orders and draw votes of the game again,
+// starting from the given state, which has to be the state the game started
+// from.
+func (g *Game) Replay(start *State) (*Game, error) {
+	replay := NewGame(start)
+	for _, record := range g.History {
+		for _, submitted := range record.Orders {
+			order, err := start.ParseOrder(submitted.Country, submitted.Order)
+			if err != nil {
+				return nil, err
+			}
+			err = start.AddOrder(submitted.Country, order)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, vote := range record.Draws {
+			err := replay.replayVote(vote)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if start.Finished() {
+			// the game ended in an agreed draw, which recorded the phase
+			break
+		}
+
+		_, err := replay.Adjudicate()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return replay, nil
+}
+
+// Snapshot copies the units, dislodged units and supply center owners.
+func (s *State) Snapshot() *Snapshot {
+	snapshot := &Snapshot{Units: []UnitPosition{}, Dislodged: []UnitPosition{}, SupplyCenters: map[string]string{}}
+	for _, key := range sortedProvinceKeys(s.World) {
+		p := s.World.Provinces[key]
+		if p.Unit != nil {
+			snapshot.Units = append(snapshot.Units, UnitPosition{Country: p.Unit.Country.Name, Type: p.Unit.Type, Location: location(key, p.Unit.Coast)})
+		}
+		if p.IsSupplyCenter && p.OwnedBy != nil {
+			snapshot.SupplyCenters[key] = p.OwnedBy.Name
+		}
+	}
+	for _, d := range s.Dislodged {
+		snapshot.Dislodged = append(snapshot.Dislodged, UnitPosition{Country: d.Unit.Country.Name, Type: d.Unit.Type, Location: location(d.Position.Key, d.Unit.Coast)})
+	}
+	return snapshot
+}
+
+// submittedOrders lists the orders of all countries in standard notation,
+// ordered by country and province.
+func (s *State) submittedOrders() []SubmittedOrder {
+	orders := []SubmittedOrder{}
+	for _, c := range s.Countries {
+		if c == nil {
+			continue
+		}
+		for _, order := range sortedOrders(c) {
+			orders = append(orders, SubmittedOrder{Country: c.Name, Order: order.String()})
+		}
+	}
+	return orders
+}
+
+// sortedOrders returns the orders of the country ordered by province.
+func sortedOrders(c *Country) []Order {
+	orders := append([]Order{}, c.orders...)
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].GetPosition().Key < orders[j].GetPosition().Key
+	})
+	return orders
+}
@@ -0,0 +1,155 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupGameState() *State {
+	s := setupAdjudicationState(
+		testUnit{"Austria", Army, "Tri"},
+		testUnit{"Italy", Army, "Ven"},
+		testUnit{"Italy", Army, "Tyr"},
+	)
+	s.SetOwner("Tri", "Austria")
+	s.SetOwner("Ven", "Italy")
+	return s
+}
+
+func playGame(t *testing.T, g *Game) {
+	assert.NoError(t, g.State.AddMoveOrder("Italy", "Ven", "Tri"))
+	assert.NoError(t, g.State.AddSupportOrder("Italy", "Tyr", "Ven", "Tri"))
+	_, err := g.Adjudicate()
+	assert.NoError(t, err)
+
+	assert.NoError(t, g.State.AddRetreatOrder("Austria", "Tri", "Alb"))
+	_, err = g.Adjudicate()
+	assert.NoError(t, err)
+
+	assert.NoError(t, g.State.AddMoveOrder("Italy", "Tyr", "Ven"))
+	_, err = g.Adjudicate()
+	assert.NoError(t, err)
+
+	_, err = g.Adjudicate()
+	assert.NoError(t, err)
+}
+
+func TestGame_History(t *testing.T) {
+	g := NewGame(setupGameState())
+	playGame(t, g)
+
+	if !assert.Len(t, g.History, 4) {
+		return
+	}
+	assert.Equal(t, 1902, g.State.Year)
+
+	spring := g.History[0]
+	assert.Equal(t, "S1901M", spring.Name)
+	assert.Equal(t, []SubmittedOrder{{"Italy", "A Tyr S Ven - Tri"}, {"Italy", "A Ven - Tri"}}, spring.Orders)
+	assert.Contains(t, spring.Before.Units, UnitPosition{"Austria", Army, "Tri"})
+	assert.Contains(t, spring.After.Units, UnitPosition{"Italy", Army, "Tri"})
+	assert.Equal(t, []UnitPosition{{"Austria", Army, "Tri"}}, spring.After.Dislodged)
+	assert.Len(t, spring.Results, 3)
+
+	retreat := g.History[1]
+	assert.Equal(t, "S1901R", retreat.Name)
+	assert.Equal(t, []SubmittedOrder{{"Austria", "A Tri R Alb"}}, retreat.Orders)
+	assert.Contains(t, retreat.After.Units, UnitPosition{"Austria", Army, "Alb"})
+
+	fall := g.History[2]
+	assert.Equal(t, "F1901M", fall.Name)
+	assert.Equal(t, "Austria", fall.Before.SupplyCenters["Tri"])
+	assert.Equal(t, "Italy", fall.After.SupplyCenters["Tri"])
+
+	winter := g.History[3]
+	assert.Equal(t, "W1901A", winter.Name)
+	assert.NotContains(t, winter.After.Units, UnitPosition{"Austria", Army, "Alb"})
+}
+
+func TestGame_SnapshotsDoNotChange(t *testing.T) {
+	g := NewGame(setupGameState())
+	playGame(t, g)
+
+	assert.Equal(t, []UnitPosition{
+		{"Austria", Army, "Tri"},
+		{"Italy", Army, "Tyr"},
+		{"Italy", Army, "Ven"},
+	}, g.History[0].Before.Units)
+	assert.Equal(t, "A Ven - Tri", g.History[0].Results[2].Text)
+}
+
+func TestGame_Phase(t *testing.T) {
+	g := NewGame(setupGameState())
+	playGame(t, g)
+
+	record, err := g.Phase("S1901R")
+	assert.NoError(t, err)
+	assert.Equal(t, g.History[1], record)
+
+	_, err = g.Phase("S1902M")
+	assert.ErrorIs(t, err, ErrPhaseNotFound)
+	assert.EqualError(t, err, "Phase 'S1902M' not found")
+}
+
+func TestGame_Replay(t *testing.T) {
+	g := NewGame(setupGameState())
+	playGame(t, g)
+
+	replay, err := g.Replay(setupGameState())
+	assert.NoError(t, err)
+	assert.Equal(t, g.State.PhaseName(), replay.State.PhaseName())
+	assert.Equal(t, g.State.Snapshot(), replay.State.Snapshot())
+	if assert.Len(t, replay.History, len(g.History)) {
+		for i, record := range g.History {
+			assert.Equal(t, record.Orders, replay.History[i].Orders)
+			assert.Equal(t, record.After, replay.History[i].After)
+		}
+	}
+}
+
+func TestGame_RecordsDrawVotes(t *testing.T) {
+	g := NewGame(setupGameState())
+	assert.NoError(t, g.ProposeDraw("Italy"))
+	assert.NoError(t, g.RejectDraw("Austria"))
+	_, err := g.Adjudicate()
+	assert.NoError(t, err)
+
+	assert.Equal(t, []DrawVote{{"Italy", DrawProposed, nil}, {"Austria", DrawRejected, nil}}, g.History[0].Draws)
+	assert.ErrorIs(t, g.AcceptDraw("Austria"), ErrNoDrawProposal)
+}
+
+func setupDrawState() *State {
+	s := setupGameState()
+	for _, c := range s.Countries {
+		c.Eliminated = c.Name != "Austria" && c.Name != "Italy"
+	}
+	return s
+}
+
+func TestGame_ReplayDraw(t *testing.T) {
+	g := NewGame(setupDrawState())
+	_, err := g.Adjudicate()
+	assert.NoError(t, err)
+	assert.NoError(t, g.State.AddMoveOrder("Italy", "Ven", "Tri"))
+	assert.NoError(t, g.ProposeDraw("Italy", "Austria", "Italy"))
+	assert.NoError(t, g.AcceptDraw("Austria"))
+
+	if !assert.Len(t, g.History, 2) {
+		return
+	}
+	draw := g.History[1]
+	assert.Equal(t, "F1901M", draw.Name)
+	assert.Equal(t, []SubmittedOrder{{"Italy", "A Ven - Tri"}}, draw.Orders)
+	assert.Equal(t, []DrawVote{{"Italy", DrawProposed, []string{"Austria", "Italy"}}, {"Austria", DrawAccepted, nil}}, draw.Draws)
+	assert.Nil(t, draw.Results)
+	assert.Equal(t, draw.Before, draw.After)
+
+	replay, err := g.Replay(setupDrawState())
+	assert.NoError(t, err)
+	assert.True(t, replay.State.Finished())
+	assert.Equal(t, g.State.Outcome, replay.State.Outcome)
+	if assert.Len(t, replay.History, len(g.History)) {
+		assert.Equal(t, draw.Draws, replay.History[1].Draws)
+	}
+}
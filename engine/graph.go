@@ -1,12 +1,16 @@
 package engine
 
 import (
-	"errors"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 )
 
 type TileType int8
 type UnitType int8
+type MoveKind int8
+type Coast string
 
 const (
 	LandTile TileType = iota
@@ -18,8 +22,24 @@ const (
 	Fleet
 )
 
+const (
+	ArmyMove MoveKind = 1 << iota
+	FleetMove
+	AnyMove = ArmyMove | FleetMove
+)
+
+const (
+	NoCoast    Coast = ""
+	NorthCoast Coast = "nc"
+	SouthCoast Coast = "sc"
+	EastCoast  Coast = "ec"
+)
+
 type Graph struct {
 	Provinces map[string]*Province
+	// unknownEdges records the edges that were added to or from unknown
+	// locations, so Validate can report them.
+	unknownEdges []string
 }
 
 type Province struct {
@@ -27,59 +47,129 @@ type Province struct {
 	Name           string
 	Type           TileType
 	IsSupplyCenter bool
-	OwnedBy        string
+	OwnedBy        *Country
 	Unit           *Unit
 	Edges          map[string]*Edge
+	Coasts         []Coast
 }
 
+// Edge connects a province to a neighbor. Fleets moving from or to a
+// province with several coasts may only use the coast links of the edge.
 type Edge struct {
 	Province *Province
+	Kind     MoveKind
+	Coasts   []CoastLink
+}
+
+type CoastLink struct {
+	From Coast `json:"from"`
+	To   Coast `json:"to"`
+}
+
+// Allows reports whether units of the given type may move along the edge.
+func (e *Edge) Allows(unitType UnitType) bool {
+	switch unitType {
+	case Army:
+		return e.Kind&ArmyMove != 0
+	case Fleet:
+		return e.Kind&FleetMove != 0
+	}
+	return false
 }
 
 type Unit struct {
 	Order   Order
 	Country *Country
 	Type    UnitType
+	Coast   Coast
 }
 
 func (g *Graph) AddProvince(key, name string, tileType TileType, isSupplyCenter bool) {
 	g.Provinces[key] = &Province{Key: key, Name: name, Type: tileType, IsSupplyCenter: isSupplyCenter, Edges: map[string]*Edge{}}
 }
 
-func (g *Graph) GetProvince(key string) (*Province, error) {
+// AddCoasts splits the coastline of a province into separately reachable
+// coasts, like the north and south coast of Spain.
+func (g *Graph) AddCoasts(key string, coasts ...Coast) {
 	if _, ok := g.Provinces[key]; !ok {
-		return nil, errors.New(fmt.Sprintf("Province '%s' not found", key))
+		return
 	}
 
-	return g.Provinces[key], nil
+	g.Provinces[key].Coasts = append(g.Provinces[key].Coasts, coasts...)
 }
 
-func (g *Graph) AddEdge(srcKey, destKey string) {
-	if _, ok := g.Provinces[srcKey]; !ok {
-		return
+// HasCoast reports whether the coast is one of the named coasts of the
+// province.
+func (p *Province) HasCoast(coast Coast) bool {
+	for _, c := range p.Coasts {
+		if c == coast {
+			return true
+		}
 	}
-	if _, ok := g.Provinces[destKey]; !ok {
-		return
+	return false
+}
+
+// GetLocation resolves a location like "Spa" or "Spa/nc" to a province and
+// an optional coast.
+func (g *Graph) GetLocation(location string) (*Province, Coast, error) {
+	key, coast, _ := strings.Cut(location, "/")
+
+	province, err := g.GetProvince(key)
+	if err != nil {
+		return nil, NoCoast, err
+	}
+
+	if coast != "" && !province.HasCoast(Coast(coast)) {
+		return nil, NoCoast, &CoastNotFoundError{Key: key, Coast: Coast(coast)}
+	}
+
+	return province, Coast(coast), nil
+}
+
+func location(key string, coast Coast) string {
+	if coast == NoCoast {
+		return key
+	}
+	return key + "/" + string(coast)
+}
+
+func (g *Graph) GetProvince(key string) (*Province, error) {
+	if _, ok := g.Provinces[key]; !ok {
+		return nil, &ProvinceNotFoundError{Key: key}
 	}
 
-	g.Provinces[srcKey].Edges[destKey] = &Edge{Province: g.Provinces[destKey]}
+	return g.Provinces[key], nil
 }
 
+func (g *Graph) AddEdge(srcKey, destKey string) {
+	g.addEdges(srcKey, []string{destKey}, AnyMove)
+}
+
+// AddUnit places a unit on a location. Fleets in provinces with several
+// coasts have to be placed on one of them, e.g. "Stp/sc".
 func (g *Graph) AddUnit(country *Country, unitType UnitType, province string) (*Unit, error) {
-	if _, ok := g.Provinces[province]; !ok {
-		return nil, errors.New("Province not found")
+	p, coast, err := g.GetLocation(province)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Unit != nil {
+		return nil, ErrProvinceOccupied
 	}
 
-	if g.Provinces[province].Unit != nil {
-		return nil, errors.New("Province already occupied")
+	if unitType != Fleet {
+		coast = NoCoast
+	} else if len(p.Coasts) > 0 && coast == NoCoast {
+		return nil, &CoastRequiredError{Key: p.Key}
 	}
 
 	unit := &Unit{
 		Country: country,
 		Type:    unitType,
+		Coast:   coast,
 	}
 
-	g.Provinces[province].Unit = unit
+	p.Unit = unit
 
 	return unit, nil
 }
@@ -87,27 +177,115 @@ func (g *Graph) AddUnit(country *Country, unitType UnitType, province string) (*
 func (g *Graph) GetUnits(country string) []*Unit {
 	units := []*Unit{}
 	for _, tile := range g.Provinces {
-		if tile.Unit.Country.Name == country {
+		if tile.Unit != nil && tile.Unit.Country.Name == country {
 			units = append(units, tile.Unit)
 		}
 	}
 	return units
 }
 
+// AddEdges connects a province to its neighbors for armies and fleets.
 func (g *Graph) AddEdges(srcKey string, destKeys []string) {
-	if _, ok := g.Provinces[srcKey]; !ok {
+	g.addEdges(srcKey, destKeys, AnyMove)
+}
+
+// AddArmyEdges connects a province to neighbors that only armies can reach.
+func (g *Graph) AddArmyEdges(srcKey string, destKeys []string) {
+	g.addEdges(srcKey, destKeys, ArmyMove)
+}
+
+// AddFleetEdges connects a province to neighbors that only fleets can reach.
+func (g *Graph) AddFleetEdges(srcKey string, destKeys []string) {
+	g.addEdges(srcKey, destKeys, FleetMove)
+}
+
+func (g *Graph) addEdges(srcKey string, destKeys []string, kind MoveKind) {
+	src, srcCoast, err := g.GetLocation(srcKey)
+	if err != nil {
+		// the army, fleet and shared adjacencies of a location are added
+		// separately, report the unknown location only once
+		problem := fmt.Sprintf("Adjacencies of unknown location '%s'", srcKey)
+		if !slices.Contains(g.unknownEdges, problem) {
+			g.unknownEdges = append(g.unknownEdges, problem)
+		}
 		return
 	}
 
 	for _, destKey := range destKeys {
-		if _, ok := g.Provinces[destKey]; !ok {
-			return
+		dest, destCoast, err := g.GetLocation(destKey)
+		if err != nil {
+			g.unknownEdges = append(g.unknownEdges, fmt.Sprintf("Adjacency from '%s' to unknown location '%s'", srcKey, destKey))
+			continue
+		}
+
+		edge, ok := src.Edges[dest.Key]
+		if !ok {
+			edge = &Edge{Province: dest}
+			src.Edges[dest.Key] = edge
 		}
+		edge.Kind |= kind
 
-		g.Provinces[srcKey].Edges[destKey] = &Edge{Province: g.Provinces[destKey]}
+		if kind&FleetMove != 0 && (srcCoast != NoCoast || destCoast != NoCoast) {
+			edge.Coasts = append(edge.Coasts, CoastLink{From: srcCoast, To: destCoast})
+		}
 	}
 }
 
+// CanMove reports whether a unit of the given type can move from one province
+// directly to the other, using any of their coasts.
+func (g *Graph) CanMove(unitType UnitType, from, to *Province) bool {
+	return g.CanMoveCoast(unitType, from, NoCoast, to, NoCoast)
+}
+
+// CanMoveCoast reports whether a unit of the given type can move from a
+// location directly to another one. For fleets the coasts are taken into
+// account, where NoCoast matches any coast of a province.
+func (g *Graph) CanMoveCoast(unitType UnitType, from *Province, fromCoast Coast, to *Province, toCoast Coast) bool {
+	if from == nil || to == nil {
+		return false
+	}
+
+	edge, ok := from.Edges[to.Key]
+	if !ok || !edge.Allows(unitType) {
+		return false
+	}
+
+	if unitType != Fleet || (len(from.Coasts) == 0 && len(to.Coasts) == 0) {
+		return true
+	}
+
+	for _, link := range edge.Coasts {
+		fromMatches := len(from.Coasts) == 0 || fromCoast == NoCoast || link.From == fromCoast
+		toMatches := len(to.Coasts) == 0 || toCoast == NoCoast || link.To == toCoast
+		if fromMatches && toMatches {
+			return true
+		}
+	}
+
+	return false
+}
+
+// canMoveTo checks whether a unit can move directly to a location. Fleets
+// moving to a province with several coasts have to name the coast.
+func (g *Graph) canMoveTo(unit *Unit, from, to *Province, coast Coast) bool {
+	if unit.Type == Fleet && len(to.Coasts) > 0 && coast == NoCoast {
+		return false
+	}
+	return g.CanMoveCoast(unit.Type, from, unit.Coast, to, coast)
+}
+
+// ReachableCoasts lists the coasts of a province with several coasts that a
+// fleet can reach from the given location.
+func (g *Graph) ReachableCoasts(from *Province, fromCoast Coast, to *Province) []Coast {
+	coasts := []Coast{}
+	for _, coast := range to.Coasts {
+		if g.CanMoveCoast(Fleet, from, fromCoast, to, coast) {
+			coasts = append(coasts, coast)
+		}
+	}
+	return coasts
+}
+
 func (g *Graph) GetNeighborKeys(srcKey string) []string {
 	result := []string{}
 
@@ -120,7 +298,7 @@ func (g *Graph) GetNeighborKeys(srcKey string) []string {
 
 func (g *Graph) GetNeighbors(src *Province) ([]*Province, error) {
 	if src == nil {
-		return nil, errors.New("input is nil")
+		return nil, ErrNilProvince
 	}
 
 	result := []*Province{}
@@ -134,7 +312,7 @@ func (g *Graph) GetNeighbors(src *Province) ([]*Province, error) {
 
 func (g *Graph) GetNeighborsWithUnits(src *Province) ([]*Province, error) {
 	if src == nil {
-		return nil, errors.New("input is nil")
+		return nil, ErrNilProvince
 	}
 
 	result := []*Province{}
@@ -148,3 +326,21 @@ func (g *Graph) GetNeighborsWithUnits(src *Province) ([]*Province, error) {
 
 	return result, nil
 }
+
+func sortedProvinceKeys(g *Graph) []string {
+	keys := make([]string, 0, len(g.Provinces))
+	for key := range g.Provinces {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedEdgeKeys(p *Province) []string {
+	keys := make([]string, 0, len(p.Edges))
+	for key := range p.Edges {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -1,8 +1,10 @@
 package engine
 
 import (
-	"github.com/stretchr/testify/assert"
+	"fmt"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAddVertex(t *testing.T) {
@@ -73,6 +75,62 @@ func TestAddEdge_AddEdgesToMissingVertex(t *testing.T) {
 	assert.Nil(g.Provinces["ABC"].Edges["GHI"])
 }
 
+func TestAddEdges_MoveKinds(t *testing.T) {
+	assert := assert.New(t)
+
+	g := &Graph{Provinces: map[string]*Province{}}
+
+	g.AddProvince("ABC", "Test", LandTile, false)
+	g.AddProvince("DEF", "Another one", LandTile, false)
+	g.AddProvince("GHI", "Another one", WaterTile, false)
+	g.AddProvince("JKL", "Another one", LandTile, false)
+	g.AddArmyEdges("ABC", []string{"DEF", "JKL"})
+	g.AddFleetEdges("ABC", []string{"GHI", "JKL"})
+	g.AddEdge("DEF", "ABC")
+
+	assert.Equal(ArmyMove, g.Provinces["ABC"].Edges["DEF"].Kind)
+	assert.Equal(FleetMove, g.Provinces["ABC"].Edges["GHI"].Kind)
+	assert.Equal(AnyMove, g.Provinces["ABC"].Edges["JKL"].Kind)
+	assert.Equal(AnyMove, g.Provinces["DEF"].Edges["ABC"].Kind)
+}
+
+func TestCanMove(t *testing.T) {
+	g := initializeWorld()
+
+	tests := []struct {
+		unitType UnitType
+		from     string
+		to       string
+		expected bool
+	}{
+		{Army, "Bur", "Mar", true},
+		{Fleet, "Bur", "Mar", false},
+		{Army, "Mar", "Pie", true},
+		{Fleet, "Mar", "Pie", true},
+		{Fleet, "Spa/nc", "MAO", true},
+		{Fleet, "Spa/nc", "Mar", false},
+		{Fleet, "Spa/sc", "Mar", true},
+		{Army, "Spa", "Mar", true},
+		{Fleet, "MAO", "Spa/nc", true},
+		{Fleet, "Gas", "Spa/sc", false},
+		{Fleet, "Stp/sc", "BAR", false},
+		{Army, "Bre", "MAO", false},
+		{Fleet, "MAO", "Bre", true},
+		{Army, "Mun", "Par", false},
+		{Fleet, "AEG", "BLA", false},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s %s - %s", test.unitType, test.from, test.to), func(t *testing.T) {
+			from, fromCoast, _ := g.GetLocation(test.from)
+			to, toCoast, _ := g.GetLocation(test.to)
+			assert.Equal(t, test.expected, g.CanMoveCoast(test.unitType, from, fromCoast, to, toCoast))
+		})
+	}
+
+	assert.False(t, g.CanMove(Army, nil, g.Provinces["Mar"]))
+}
+
 func TestGetNeighbors(t *testing.T) {
 	assert := assert.New(t)
 
@@ -142,6 +200,43 @@ func TestAddUnit_AddUnitToOccupiedTile(t *testing.T) {
 	assert.Error(err)
 }
 
+func TestAddUnit_AddUnitToCoast(t *testing.T) {
+	assert := assert.New(t)
+
+	g := initializeWorld()
+	country := &Country{Name: "Russia"}
+
+	_, err := g.AddUnit(country, Fleet, "Stp")
+	assert.EqualError(err, "Fleets in 'Stp' need a coast")
+
+	unit, err := g.AddUnit(country, Fleet, "Stp/nc")
+	assert.NoError(err)
+	assert.Equal(NorthCoast, unit.Coast)
+	assert.Equal(unit, g.Provinces["Stp"].Unit)
+
+	_, err = g.AddUnit(country, Army, "Stp")
+	assert.Error(err)
+}
+
+func TestGetLocation(t *testing.T) {
+	assert := assert.New(t)
+
+	g := initializeWorld()
+
+	province, coast, err := g.GetLocation("Spa/nc")
+	assert.NoError(err)
+	assert.Equal("Spa", province.Key)
+	assert.Equal(NorthCoast, coast)
+
+	province, coast, err = g.GetLocation("Mar")
+	assert.NoError(err)
+	assert.Equal("Mar", province.Key)
+	assert.Equal(NoCoast, coast)
+
+	_, _, err = g.GetLocation("Spa/ec")
+	assert.EqualError(err, "Province 'Spa' has no coast 'ec'")
+}
+
 func TestGetUnits_NoUnits(t *testing.T) {
 	graph := Graph{Provinces: map[string]*Province{}}
 	assert.Empty(t, graph.GetUnits("France"), "There should be no units for an empty graph.")
@@ -14,7 +14,7 @@ func TestVisualizeInitializedWorld(t *testing.T) {
 	assert := assert.New(t)
 	filename := "./world.dot"
 
-	game, err := InitializeNewGame()
+	game, err := InitializeNewGame(StandardVariant)
 	assert.NoError(err)
 	world := game.World
 	g := graph.New(graph.StringHash)
@@ -0,0 +1,212 @@
+package engine
+
+import "fmt"
+
+// Validate checks that the map is consistent: every edge leads to a province
+// of the map, including the edges added to unknown locations, and has a
+// matching edge back, armies only move over land, fleets only along coasts
+// and seas, coasts are named where needed and all provinces can be reached.
+// All problems found are reported in one InvalidMapError.
+func (g *Graph) Validate() error {
+	problems := append(append([]string{}, g.unknownEdges...), g.problems()...)
+	if len(problems) > 0 {
+		return &InvalidMapError{Problems: problems}
+	}
+	return nil
+}
+
+func (g *Graph) problems() []string {
+	problems := []string{}
+	report := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	for _, key := range sortedProvinceKeys(g) {
+		p := g.Provinces[key]
+		if p.Key != key {
+			report("Province '%s' is listed as '%s'", p.Key, key)
+		}
+		if p.Type == WaterTile && (p.IsSupplyCenter || len(p.Coasts) > 0) {
+			report("Sea '%s' cannot be a supply center or have coasts", key)
+		}
+
+		for _, to := range sortedEdgeKeys(p) {
+			edge := p.Edges[to]
+			dest, ok := g.Provinces[to]
+			if !ok || edge.Province != dest {
+				report("Edge from '%s' leads to unknown province '%s'", key, to)
+				continue
+			}
+			for _, problem := range edgeProblems(p, edge) {
+				report("Edge from '%s' to '%s' %s", key, to, problem)
+			}
+		}
+	}
+
+	for _, key := range g.unreachable() {
+		report("Province '%s' cannot be reached", key)
+	}
+
+	return problems
+}
+
+// edgeProblems checks an edge against the edge leading back and the tiles it
+// connects.
+func edgeProblems(from *Province, edge *Edge) []string {
+	to := edge.Province
+	problems := []string{}
+
+	back, ok := to.Edges[from.Key]
+	if !ok {
+		return append(problems, "has no edge back")
+	}
+	if back.Kind != edge.Kind {
+		problems = append(problems, "allows other units than the edge back")
+	}
+	for _, link := range edge.Coasts {
+		if !hasCoastLink(back, CoastLink{From: link.To, To: link.From}) {
+			problems = append(problems, fmt.Sprintf("links coast '%s' to '%s' only in one direction", link.From, link.To))
+		}
+		if link.From != NoCoast && !from.HasCoast(link.From) || link.To != NoCoast && !to.HasCoast(link.To) {
+			problems = append(problems, fmt.Sprintf("links unknown coast '%s' to '%s'", link.From, link.To))
+		}
+	}
+
+	if edge.Kind&ArmyMove != 0 && (from.Type == WaterTile || to.Type == WaterTile) {
+		problems = append(problems, "lets armies move at sea")
+	}
+	if edge.Kind&FleetMove != 0 {
+		if from.Type == LandTile && !isCoastal(from) || to.Type == LandTile && !isCoastal(to) {
+			problems = append(problems, "lets fleets move inland")
+		}
+		if len(edge.Coasts) == 0 && (len(from.Coasts) > 0 || len(to.Coasts) > 0) {
+			problems = append(problems, "does not name a coast")
+		}
+	}
+
+	return problems
+}
+
+func hasCoastLink(edge *Edge, link CoastLink) bool {
+	for _, l := range edge.Coasts {
+		if l == link {
+			return true
+		}
+	}
+	return false
+}
+
+// unreachable lists the provinces that cannot be reached from the first
+// province of the map by any unit.
+func (g *Graph) unreachable() []string {
+	keys := sortedProvinceKeys(g)
+	if len(keys) == 0 {
+		return []string{}
+	}
+
+	reached := map[string]bool{keys[0]: true}
+	queue := []*Province{g.Provinces[keys[0]]}
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		for to := range p.Edges {
+			if next, ok := g.Provinces[to]; ok && !reached[to] {
+				reached[to] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	unreachable := []string{}
+	for _, key := range keys {
+		if !reached[key] {
+			unreachable = append(unreachable, key)
+		}
+	}
+	return unreachable
+}
+
+// Validate checks the definition for unknown keys, duplicate provinces, home
+// centers that are no supply centers and starting units that cannot stand
+// where they are placed, and then validates the map it builds.
+func (m *MapDefinition) Validate() error {
+	problems := []string{}
+	report := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	g := &Graph{Provinces: map[string]*Province{}}
+	for _, p := range m.Provinces {
+		if _, ok := g.Provinces[p.Key]; ok {
+			report("Province '%s' is defined twice", p.Key)
+			continue
+		}
+		tileType, err := lookupName(tileTypeNames, "tile type", p.Type)
+		if err != nil {
+			report("Province '%s' has an unknown type '%s'", p.Key, p.Type)
+		}
+		g.AddProvince(p.Key, p.Name, tileType, p.SupplyCenter)
+		g.AddCoasts(p.Key, p.Coasts...)
+	}
+
+	for _, a := range m.Adjacencies {
+		g.AddEdges(a.From, a.Any)
+		g.AddArmyEdges(a.From, a.Army)
+		g.AddFleetEdges(a.From, a.Fleet)
+	}
+	problems = append(problems, g.unknownEdges...)
+
+	occupied := map[string]bool{}
+	for _, power := range m.Powers {
+		for _, key := range power.HomeCenters {
+			p, ok := g.Provinces[key]
+			if !ok {
+				report("Home center '%s' of %s is unknown", key, power.Name)
+			} else if !p.IsSupplyCenter {
+				report("Home center '%s' of %s is not a supply center", key, power.Name)
+			}
+		}
+
+		for _, unit := range power.Units {
+			key, problem := startingUnitProblem(g, unit)
+			if problem == "" && occupied[key] {
+				problem = "shares its province with another unit"
+			}
+			occupied[key] = true
+			if problem != "" {
+				report("Starting unit '%s' of %s %s", unit, power.Name, problem)
+			}
+		}
+	}
+
+	problems = append(problems, g.problems()...)
+	if len(problems) > 0 {
+		return &InvalidMapError{Problems: problems}
+	}
+	return nil
+}
+
+// startingUnitProblem returns the province of a starting unit and what is
+// wrong with it, if anything.
+func startingUnitProblem(g *Graph, unit string) (string, string) {
+	unitType, location, err := parseStartingUnit(unit)
+	if err != nil {
+		return "", "is not written like 'A Vie' or 'F Stp/sc'"
+	}
+	p, coast, err := g.GetLocation(location)
+	if err != nil {
+		return "", "stands on an unknown location"
+	}
+
+	switch {
+	case unitType == Army && p.Type == WaterTile:
+		return p.Key, "is an army at sea"
+	case unitType == Army && coast != NoCoast:
+		return p.Key, "is an army on a coast"
+	case unitType == Fleet && p.Type == LandTile && !isCoastal(p):
+		return p.Key, "is a fleet inland"
+	case unitType == Fleet && len(p.Coasts) > 0 && coast == NoCoast:
+		return p.Key, "is a fleet without a coast"
+	}
+	return p.Key, ""
+}
@@ -0,0 +1,128 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate_StandardMap(t *testing.T) {
+	assert.NoError(t, StandardMap().Validate())
+	assert.NoError(t, initializeWorld().Validate())
+}
+
+func TestGraphValidate(t *testing.T) {
+	g := &Graph{Provinces: map[string]*Province{}}
+	g.AddProvince("Vie", "Vienna", LandTile, true)
+	g.AddProvince("Boh", "Bohemia", LandTile, false)
+	g.AddProvince("Tri", "Trieste", LandTile, true)
+	g.AddProvince("ADR", "Adriatic Sea", WaterTile, false)
+	g.AddProvince("Gal", "Galicia", LandTile, false)
+	g.AddArmyEdges("Vie", []string{"Boh", "Tri"})
+	g.AddArmyEdges("Boh", []string{"Vie", "ADR"})
+	g.AddEdges("Tri", []string{"Vie"})
+	g.AddFleetEdges("Tri", []string{"ADR"})
+	g.AddFleetEdges("ADR", []string{"Tri"})
+
+	err := g.Validate()
+	assert.ErrorIs(t, err, ErrInvalidMap)
+
+	var mapErr *InvalidMapError
+	if assert.ErrorAs(t, err, &mapErr) {
+		assert.Equal(t, []string{
+			"Edge from 'Boh' to 'ADR' has no edge back",
+			"Edge from 'Tri' to 'Vie' allows other units than the edge back",
+			"Edge from 'Tri' to 'Vie' lets fleets move inland",
+			"Edge from 'Vie' to 'Tri' allows other units than the edge back",
+			"Province 'Gal' cannot be reached",
+		}, mapErr.Problems)
+	}
+}
+
+func TestGraphValidate_UnknownLocations(t *testing.T) {
+	g := &Graph{Provinces: map[string]*Province{}}
+	g.AddProvince("Vie", "Vienna", LandTile, true)
+	g.AddProvince("Boh", "Bohemia", LandTile, false)
+	g.AddEdges("Vie", []string{"Boh", "Bud"})
+	g.AddArmyEdges("Boh", []string{"Vie"})
+	g.AddEdges("Bud", []string{"Vie"})
+	g.AddFleetEdges("Vie", []string{"Vie/sc"})
+	g.AddEdges("Nope", nil)
+	g.AddArmyEdges("Nope", []string{})
+
+	var mapErr *InvalidMapError
+	if assert.ErrorAs(t, g.Validate(), &mapErr) {
+		assert.Equal(t, []string{
+			"Adjacency from 'Vie' to unknown location 'Bud'",
+			"Adjacencies of unknown location 'Bud'",
+			"Adjacency from 'Vie' to unknown location 'Vie/sc'",
+			"Adjacencies of unknown location 'Nope'",
+			"Edge from 'Boh' to 'Vie' allows other units than the edge back",
+			"Edge from 'Vie' to 'Boh' allows other units than the edge back",
+			"Edge from 'Vie' to 'Boh' lets fleets move inland",
+		}, mapErr.Problems)
+	}
+}
+
+func TestGraphValidate_Coasts(t *testing.T) {
+	g := &Graph{Provinces: map[string]*Province{}}
+	g.AddProvince("Spa", "Spain", LandTile, true)
+	g.AddCoasts("Spa", NorthCoast, SouthCoast)
+	g.AddProvince("MAO", "Mid-Atlantic Ocean", WaterTile, false)
+	g.AddProvince("WES", "Western Mediterranean", WaterTile, false)
+	g.AddFleetEdges("MAO", []string{"Spa/nc", "WES"})
+	g.AddFleetEdges("Spa/sc", []string{"MAO"})
+	g.AddFleetEdges("WES", []string{"MAO", "Spa"})
+	g.AddFleetEdges("Spa", []string{"WES"})
+
+	var mapErr *InvalidMapError
+	if assert.ErrorAs(t, g.Validate(), &mapErr) {
+		assert.Equal(t, []string{
+			"Edge from 'MAO' to 'Spa' links coast '' to 'nc' only in one direction",
+			"Edge from 'Spa' to 'MAO' links coast 'sc' to '' only in one direction",
+			"Edge from 'Spa' to 'WES' does not name a coast",
+			"Edge from 'WES' to 'Spa' does not name a coast",
+		}, mapErr.Problems)
+	}
+}
+
+func TestMapDefinitionValidate(t *testing.T) {
+	data := strings.NewReplacer(
+		`{"from": "Vie", "army": ["Tri"]}`, `{"from": "Vie", "army": ["Tri", "Bud"]}, {"from": "Bud", "army": ["Vie"]}, {"from": "Nope"}`,
+		`"homeCenters": ["Vie", "Tri"]`, `"homeCenters": ["Vie", "Tri", "ADR", "Boh"]`,
+		`"units": ["A Vie", "F Tri"]`, `"units": ["A Vie", "A ADR", "F Vie", "A Spa/sc", "Tri"]`,
+		`{"key": "ADR", "name": "Adriatic Sea", "type": "water"}`, `{"key": "ADR", "name": "Adriatic Sea", "type": "water"}, {"key": "Vie", "name": "Vienna", "type": "land"}`,
+	).Replace(testMap)
+	m, err := ParseMap([]byte(data))
+	assert.NoError(t, err)
+
+	err = m.Validate()
+	assert.ErrorIs(t, err, ErrInvalidMap)
+
+	var mapErr *InvalidMapError
+	if assert.ErrorAs(t, err, &mapErr) {
+		assert.Equal(t, []string{
+			"Province 'Vie' is defined twice",
+			"Adjacency from 'Vie' to unknown location 'Bud'",
+			"Adjacencies of unknown location 'Bud'",
+			"Adjacencies of unknown location 'Nope'",
+			"Home center 'ADR' of Austria is not a supply center",
+			"Home center 'Boh' of Austria is unknown",
+			"Starting unit 'A ADR' of Austria is an army at sea",
+			"Starting unit 'F Vie' of Austria is a fleet inland",
+			"Starting unit 'A Spa/sc' of Austria is an army on a coast",
+			"Starting unit 'Tri' of Austria is not written like 'A Vie' or 'F Stp/sc'",
+			"Starting unit 'F Spa/sc' of Spain shares its province with another unit",
+		}, mapErr.Problems)
+	}
+}
+
+func TestAddEdges_SkipsUnknownProvinces(t *testing.T) {
+	g := &Graph{Provinces: map[string]*Province{}}
+	g.AddProvince("Vie", "Vienna", LandTile, true)
+	g.AddProvince("Boh", "Bohemia", LandTile, false)
+	g.AddArmyEdges("Vie", []string{"Xyz", "Boh"})
+
+	assert.Equal(t, []string{"Boh"}, sortedEdgeKeys(g.Provinces["Vie"]))
+}
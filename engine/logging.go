@@ -0,0 +1,26 @@
+package engine
+
+import (
+	"context"
+	"log/slog"
+)
+
+// discardHandler drops every record. It is used while no logger is set, so
+// the engine stays silent by default.
+type discardHandler struct{}
+
+func (discardHandler) Enabled(context.Context, slog.Level) bool  { return false }
+func (discardHandler) Handle(context.Context, slog.Record) error { return nil }
+func (h discardHandler) WithAttrs([]slog.Attr) slog.Handler      { return h }
+func (h discardHandler) WithGroup(string) slog.Handler           { return h }
+
+var discardLogger = slog.New(discardHandler{})
+
+// logger returns the logger of the state, or one discarding all records if
+// none is set.
+func (s *State) logger() *slog.Logger {
+	if s.Logger == nil {
+		return discardLogger
+	}
+	return s.Logger
+}
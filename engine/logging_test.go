@@ -0,0 +1,49 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogger_SilentByDefault(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"Austria", Army, "Vie"})
+
+	assert.Same(t, discardLogger, s.logger())
+	assert.False(t, s.logger().Enabled(context.Background(), slog.LevelError))
+}
+
+func TestLogger_TracesDecisions(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Austria", Army, "Vie"},
+		testUnit{"Austria", Army, "Bud"},
+		testUnit{"Italy", Army, "Tri"},
+	)
+	var out bytes.Buffer
+	s.Logger = slog.New(slog.NewTextHandler(&out, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	assert.NoError(t, s.AddMoveOrder("Austria", "Vie", "Tri"))
+	assert.NoError(t, s.AddSupportOrder("Austria", "Bud", "Vie", "Tri"))
+
+	adjudicate(t, s)
+
+	assert.Contains(t, out.String(), `msg="Adjudication starting" phase=S1901M`)
+	assert.Contains(t, out.String(), `msg=SupportCounted support="A Bud S Vie - Tri" order="A Vie - Tri"`)
+	assert.Contains(t, out.String(), `msg=StrengthComputed order="A Vie - Tri" strength=2`)
+	assert.Contains(t, out.String(), `msg=OrderResolved order="A Vie - Tri" success=true`)
+	assert.Contains(t, out.String(), `msg="Unit dislodged" country=Italy province=Tri from=Vie`)
+}
+
+func TestLogger_InfoLevelOmitsTrace(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"Austria", Army, "Vie"})
+	var out bytes.Buffer
+	s.Logger = slog.New(slog.NewTextHandler(&out, nil))
+	assert.NoError(t, s.AddMoveOrder("Austria", "Vie", "Tri"))
+
+	adjudicate(t, s)
+
+	assert.Contains(t, out.String(), "Adjudication starting")
+	assert.NotContains(t, out.String(), "OrderResolved")
+}
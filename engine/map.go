@@ -0,0 +1,167 @@
+package engine
+
+import (
+	"embed"
+	"encoding/json"
+	"strings"
+)
+
+// builtin holds the maps and variants that ship with the engine.
+//
+//go:embed maps/*.json variants/*.json
+var builtin embed.FS
+
+// MapDefinition describes a map and the start of a game on it: the provinces,
+// which provinces units of each kind can move between, and the powers with
+// their home centers and starting units.
+type MapDefinition struct {
+	Name        string                `json:"name"`
+	StartYear   int                   `json:"startYear"`
+	Provinces   []ProvinceDefinition  `json:"provinces"`
+	Adjacencies []AdjacencyDefinition `json:"adjacencies"`
+	Powers      []PowerDefinition     `json:"powers"`
+}
+
+// ProvinceDefinition describes a province. The type is "land" or "water".
+type ProvinceDefinition struct {
+	Key          string  `json:"key"`
+	Name         string  `json:"name"`
+	Type         string  `json:"type"`
+	SupplyCenter bool    `json:"supplyCenter,omitempty"`
+	Coasts       []Coast `json:"coasts,omitempty"`
+}
+
+// AdjacencyDefinition lists the neighbors of a location that can be reached
+// by any unit, only by armies and only by fleets. Locations of fleets may
+// name a coast, like "Spa/nc".
+type AdjacencyDefinition struct {
+	From  string   `json:"from"`
+	Any   []string `json:"any,omitempty"`
+	Army  []string `json:"army,omitempty"`
+	Fleet []string `json:"fleet,omitempty"`
+}
+
+// PowerDefinition describes a power and its starting units, written like
+// "A Vie" or "F Stp/sc".
+type PowerDefinition struct {
+	Name        string   `json:"name"`
+	HomeCenters []string `json:"homeCenters"`
+	Units       []string `json:"units"`
+}
+
+// ParseMap reads a map definition in JSON.
+func ParseMap(data []byte) (*MapDefinition, error) {
+	m := &MapDefinition{}
+	err := json.Unmarshal(data, m)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StandardMap returns the definition of the standard map.
+func StandardMap() *MapDefinition {
+	m, err := builtinMap("standard")
+	if err != nil {
+		panic("the embedded standard map is invalid: " + err.Error())
+	}
+	return m
+}
+
+func builtinMap(name string) (*MapDefinition, error) {
+	data, err := builtin.ReadFile("maps/" + name + ".json")
+	if err != nil {
+		return nil, &MapNotFoundError{Name: name}
+	}
+	return ParseMap(data)
+}
+
+// Graph builds the provinces and edges of the map.
+func (m *MapDefinition) Graph() (*Graph, error) {
+	g := &Graph{Provinces: map[string]*Province{}}
+
+	for _, p := range m.Provinces {
+		tileType, err := lookupName(tileTypeNames, "tile type", p.Type)
+		if err != nil {
+			return nil, err
+		}
+		g.AddProvince(p.Key, p.Name, tileType, p.SupplyCenter)
+		g.AddCoasts(p.Key, p.Coasts...)
+	}
+
+	for _, a := range m.Adjacencies {
+		locations := append([]string{a.From}, a.Any...)
+		locations = append(locations, a.Army...)
+		locations = append(locations, a.Fleet...)
+		for _, location := range locations {
+			_, _, err := g.GetLocation(location)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		g.AddEdges(a.From, a.Any)
+		g.AddArmyEdges(a.From, a.Army)
+		g.AddFleetEdges(a.From, a.Fleet)
+	}
+
+	return g, nil
+}
+
+// NewGame builds the map and places the starting units of every power in
+// Spring of the start year. The home centers are owned by their power.
+func (m *MapDefinition) NewGame() (*State, error) {
+	world, err := m.Graph()
+	if err != nil {
+		return nil, err
+	}
+
+	game := &State{
+		Year:      m.StartYear,
+		Turn:      Spring,
+		Phase:     OrderPhase,
+		Countries: []*Country{},
+		World:     world,
+	}
+
+	for _, power := range m.Powers {
+		country := &Country{Name: power.Name, HomeCenters: power.HomeCenters}
+		game.Countries = append(game.Countries, country)
+
+		for _, hc := range power.HomeCenters {
+			p, err := world.GetProvince(hc)
+			if err != nil {
+				return nil, err
+			}
+
+			p.OwnedBy = country
+		}
+
+		for _, unit := range power.Units {
+			unitType, location, err := parseStartingUnit(unit)
+			if err != nil {
+				return nil, err
+			}
+			_, err = world.AddUnit(country, unitType, location)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return game, nil
+}
+
+func parseStartingUnit(unit string) (UnitType, string, error) {
+	typeName, location, ok := strings.Cut(unit, " ")
+	if !ok {
+		return Army, "", &UnknownValueError{Kind: "unit", Value: unit}
+	}
+
+	var unitType UnitType
+	err := unitType.UnmarshalText([]byte(typeName))
+	if err != nil {
+		return Army, "", err
+	}
+	return unitType, location, nil
+}
@@ -0,0 +1,95 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testMap = `{
+  "name": "Test",
+  "startYear": 1950,
+  "provinces": [
+    {"key": "Vie", "name": "Vienna", "type": "land", "supplyCenter": true},
+    {"key": "Tri", "name": "Trieste", "type": "land", "supplyCenter": true},
+    {"key": "ADR", "name": "Adriatic Sea", "type": "water"},
+    {"key": "Spa", "name": "Spain", "type": "land", "coasts": ["nc", "sc"]}
+  ],
+  "adjacencies": [
+    {"from": "Vie", "army": ["Tri"]},
+    {"from": "Tri", "army": ["Vie"], "fleet": ["ADR"]},
+    {"from": "ADR", "fleet": ["Tri", "Spa/sc"]},
+    {"from": "Spa/sc", "fleet": ["ADR"]}
+  ],
+  "powers": [
+    {"name": "Austria", "homeCenters": ["Vie", "Tri"], "units": ["A Vie", "F Tri"]},
+    {"name": "Spain", "homeCenters": [], "units": ["F Spa/sc"]}
+  ]
+}`
+
+func TestStandardMap_NewGame(t *testing.T) {
+	s, err := StandardMap().NewGame()
+	assert.NoError(t, err)
+
+	assert.Equal(t, "S1901M", s.PhaseName())
+	assert.Len(t, s.World.Provinces, 75)
+	assert.Len(t, s.Countries, 7)
+	assert.Len(t, s.Snapshot().Units, 22)
+	assert.Len(t, s.Snapshot().SupplyCenters, 22)
+	assertUnitAt(t, s, "Mar", "France", Army)
+	assert.Equal(t, SouthCoast, s.World.Provinces["Stp"].Unit.Coast)
+
+	centers := 0
+	for _, p := range s.World.Provinces {
+		if p.IsSupplyCenter {
+			centers++
+		}
+	}
+	assert.Equal(t, 34, centers)
+}
+
+func TestParseMap(t *testing.T) {
+	m, err := ParseMap([]byte(testMap))
+	assert.NoError(t, err)
+	assert.Equal(t, "Test", m.Name)
+
+	s, err := m.NewGame()
+	assert.NoError(t, err)
+	assert.Equal(t, "S1950M", s.PhaseName())
+	assertUnitAt(t, s, "Tri", "Austria", Fleet)
+	assert.Equal(t, "Austria", s.World.Provinces["Vie"].OwnedBy.Name)
+
+	tri, adr, spa := s.World.Provinces["Tri"], s.World.Provinces["ADR"], s.World.Provinces["Spa"]
+	assert.True(t, s.World.CanMove(Army, s.World.Provinces["Vie"], tri))
+	assert.False(t, s.World.CanMove(Fleet, s.World.Provinces["Vie"], tri))
+	assert.True(t, s.World.CanMoveCoast(Fleet, adr, NoCoast, spa, SouthCoast))
+	assert.False(t, s.World.CanMoveCoast(Fleet, adr, NoCoast, spa, NorthCoast))
+}
+
+func TestParseMap_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		replace  [2]string
+		expected error
+	}{
+		{"unknown neighbor", [2]string{`"fleet": ["ADR"]`, `"fleet": ["ION"]`}, ErrProvinceNotFound},
+		{"unknown coast", [2]string{`"Spa/sc", "fleet"`, `"Spa/ec", "fleet"`}, ErrCoastNotFound},
+		{"unknown tile type", [2]string{`"type": "water"`, `"type": "lava"`}, ErrUnknownValue},
+		{"unknown unit type", [2]string{`"A Vie"`, `"X Vie"`}, ErrUnknownValue},
+		{"malformed unit", [2]string{`"A Vie"`, `"Vie"`}, ErrUnknownValue},
+		{"occupied province", [2]string{`"F Tri"`, `"A Vie"`}, ErrProvinceOccupied},
+		{"fleet without coast", [2]string{`"F Spa/sc"`, `"F Spa"`}, ErrCoastRequired},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := strings.Replace(testMap, test.replace[0], test.replace[1], 1)
+			m, err := ParseMap([]byte(data))
+			assert.NoError(t, err)
+
+			_, err = m.NewGame()
+			assert.ErrorIs(t, err, test.expected)
+		})
+	}
+}
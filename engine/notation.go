@@ -0,0 +1,403 @@
+package engine
+
+import (
+	"strings"
+	"unicode"
+)
+
+// token is a word of an order in standard notation together with its offset
+// in the original text, so errors can point at it.
+type token struct {
+	text   string
+	offset int
+}
+
+type orderParser struct {
+	state   *State
+	country *Country
+	input   string
+	tokens  []token
+	next    int
+}
+
+// ParseOrder parses an order of the country in standard notation, e.g.
+// "A Vie - Tri", "F Tri S A Ven - Tyr" or "F ION C A Apu - Tun". Provinces
+// may be given by key or full name, coasts like "Spa/nc" or "Spa (nc)", and
+// keywords in upper or lower case. The order is not added to the state.
+func (s *State) ParseOrder(country, text string) (Order, error) {
+	c, err := s.GetCountry(country)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &orderParser{state: s, country: c, input: text}
+	p.tokens = p.tokenize(text)
+	return p.parse()
+}
+
+// AddOrder adds a parsed order of the country, validating it the same way as
+// the AddXxxOrder methods.
+func (s *State) AddOrder(country string, order Order) error {
+	switch o := order.(type) {
+	case *HoldOrder:
+		return s.AddHoldOrder(country, o.Position.Key)
+	case *MoveOrder:
+		if o.ViaConvoy {
+			return s.AddConvoyedMoveOrder(country, o.Position.Key, o.Destination.Key)
+		}
+		return s.AddMoveOrder(country, o.Position.Key, location(o.Destination.Key, o.Coast))
+	case *SupportOrder:
+		return s.AddSupportOrder(country, o.Position.Key, o.Source.Key, o.Destination.Key)
+	case *ConvoyOrder:
+		return s.AddConvoyOrder(country, o.Position.Key, o.Source.Key, o.Destination.Key)
+	case *RetreatOrder:
+		return s.AddRetreatOrder(country, o.Position.Key, location(o.Destination.Key, o.Coast))
+	case *DisbandOrder:
+		return s.AddDisbandOrder(country, o.Position.Key)
+	case *BuildOrder:
+		return s.AddBuildOrder(country, location(o.Position.Key, o.Coast), o.Type)
+	}
+	return &UnsupportedOrderError{Order: order}
+}
+
+// tokenize splits the text into words. Moves written without spaces like
+// "Vie-Tri" and coasts like "Spa/nc" or "Spa(nc)" are split up as well,
+// while hyphenated province names like "Mid-Atlantic Ocean" are kept.
+func (p *orderParser) tokenize(text string) []token {
+	hyphenated := map[string]bool{}
+	for _, province := range p.state.World.Provinces {
+		for _, word := range strings.Fields(strings.ToLower(province.Name)) {
+			if strings.Contains(word, "-") {
+				hyphenated[word] = true
+			}
+		}
+	}
+
+	tokens := []token{}
+	add := func(text string, offset int) {
+		if text != "" {
+			tokens = append(tokens, token{text: text, offset: offset})
+		}
+	}
+
+	start := -1
+	for i, r := range text + " " {
+		if !unicode.IsSpace(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start < 0 {
+			continue
+		}
+
+		word := text[start:i]
+		if cut := strings.IndexAny(word, "/("); cut > 0 {
+			add(word[:cut], start)
+			add(word[cut:], start+cut)
+		} else if strings.Contains(word, "-") && !hyphenated[strings.ToLower(word)] {
+			offset := start
+			for word != "" {
+				i := strings.Index(word, "-")
+				if i < 0 {
+					add(word, offset)
+					break
+				}
+				arrow := 1
+				if strings.HasPrefix(word[i:], "->") {
+					arrow = 2
+				}
+				add(word[:i], offset)
+				add(word[i:i+arrow], offset+i)
+				word = word[i+arrow:]
+				offset += i + arrow
+			}
+		} else {
+			add(word, start)
+		}
+		start = -1
+	}
+
+	return tokens
+}
+
+func (p *orderParser) fail(reason error) error {
+	if p.next >= len(p.tokens) {
+		return &ParseError{Input: p.input, Offset: len(p.input), Reason: ErrIncompleteOrder}
+	}
+	t := p.tokens[p.next]
+	return &ParseError{Input: p.input, Token: t.text, Offset: t.offset, Reason: reason}
+}
+
+func (p *orderParser) peek() string {
+	if p.next >= len(p.tokens) {
+		return ""
+	}
+	return strings.ToLower(p.tokens[p.next].text)
+}
+
+// accept consumes the next token if it is one of the words.
+func (p *orderParser) accept(words ...string) bool {
+	next := p.peek()
+	for _, word := range words {
+		if next == word {
+			p.next++
+			return true
+		}
+	}
+	return false
+}
+
+func (p *orderParser) unitType() (UnitType, bool) {
+	if p.accept("a", "army") {
+		return Army, true
+	}
+	if p.accept("f", "fleet") {
+		return Fleet, true
+	}
+	return Army, false
+}
+
+func (p *orderParser) moveArrow() bool {
+	return p.accept("-", "->", "to")
+}
+
+// location reads a province given by key or by its full name, followed by an
+// optional coast.
+func (p *orderParser) location() (*Province, Coast, error) {
+	province := p.province()
+	if province == nil {
+		if p.peek() == "" {
+			return nil, NoCoast, p.fail(ErrIncompleteOrder)
+		}
+		return nil, NoCoast, p.fail(ErrProvinceNotFound)
+	}
+
+	coast := NoCoast
+	switch next := p.peek(); {
+	case strings.HasPrefix(next, "/") || strings.HasPrefix(next, "("):
+		coast = Coast(strings.Trim(next, "/()"))
+	case next == "nc" || next == "sc" || next == "ec":
+		coast = Coast(next)
+	case next == "north" || next == "south" || next == "east":
+		if p.next+1 < len(p.tokens) && strings.ToLower(p.tokens[p.next+1].text) == "coast" {
+			coast = Coast(next[:1] + "c")
+			p.next++
+		}
+	}
+	if coast == NoCoast {
+		return province, NoCoast, nil
+	}
+	if !province.HasCoast(coast) {
+		return nil, NoCoast, p.fail(ErrCoastNotFound)
+	}
+	p.next++
+	return province, coast, nil
+}
+
+// province matches the longest run of tokens naming a province.
+func (p *orderParser) province() *Province {
+	for n := len(p.tokens) - p.next; n > 0; n-- {
+		words := []string{}
+		for _, t := range p.tokens[p.next : p.next+n] {
+			words = append(words, t.text)
+		}
+		name := strings.Join(words, " ")
+		for _, key := range sortedProvinceKeys(p.state.World) {
+			province := p.state.World.Provinces[key]
+			if strings.EqualFold(name, key) || strings.EqualFold(name, province.Name) {
+				p.next += n
+				return province
+			}
+		}
+	}
+	return nil
+}
+
+// unit reads the optional unit type and the location of an ordered unit, and
+// checks that the unit is there.
+func (p *orderParser) unit() (*Province, Coast, error) {
+	typeToken := p.next
+	unitType, typed := p.unitType()
+
+	at := p.next
+	position, coast, err := p.location()
+	if err != nil {
+		return nil, NoCoast, err
+	}
+	if position.Unit == nil {
+		p.next = at
+		return nil, NoCoast, p.fail(ErrNoUnit)
+	}
+	if typed && position.Unit.Type != unitType {
+		p.next = typeToken
+		return nil, NoCoast, p.fail(ErrWrongUnitType)
+	}
+	return position, coast, nil
+}
+
+func (p *orderParser) parse() (Order, error) {
+	if p.accept("build", "builds") {
+		return p.build()
+	}
+	if p.state.Phase == RetreatPhase {
+		return p.dislodgedOrder()
+	}
+
+	at := p.next
+	unitType, typed := p.unitType()
+	position, coast, err := p.location()
+	if err != nil {
+		return nil, err
+	}
+	if p.accept("b", "build", "builds") {
+		if !typed {
+			p.next = at
+			return nil, p.fail(ErrIncompleteOrder)
+		}
+		return p.end(p.buildOrder(unitType, position, coast), nil)
+	}
+
+	p.next = at
+	position, _, err = p.unit()
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case p.peek() == "":
+		return &HoldOrder{Position: position}, nil
+	case p.accept("h", "hold", "holds"):
+		return p.end(&HoldOrder{Position: position}, nil)
+	case p.moveArrow():
+		return p.move(position)
+	case p.accept("s", "support", "supports"):
+		return p.support(position)
+	case p.accept("c", "convoy", "convoys"):
+		return p.convoy(position)
+	case p.accept("d", "disband", "disbands"):
+		return p.end(&DisbandOrder{Unit: position.Unit, Position: position}, nil)
+	}
+	return nil, p.fail(ErrUnexpectedToken)
+}
+
+// end checks that no tokens are left after the order.
+func (p *orderParser) end(order Order, err error) (Order, error) {
+	if err != nil {
+		return nil, err
+	}
+	if p.peek() != "" {
+		return nil, p.fail(ErrUnexpectedToken)
+	}
+	return order, nil
+}
+
+func (p *orderParser) move(position *Province) (Order, error) {
+	destination, coast, err := p.location()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.accept("via") {
+		if !p.accept("convoy") {
+			return nil, p.fail(ErrUnexpectedToken)
+		}
+		return p.end(&MoveOrder{Position: position, Destination: destination, ViaConvoy: true}, nil)
+	}
+
+	coast = destinationCoast(p.state.World, position.Unit, position, destination, coast)
+	return p.end(&MoveOrder{Position: position, Destination: destination, Coast: coast}, nil)
+}
+
+func (p *orderParser) support(position *Province) (Order, error) {
+	p.unitType()
+	source, _, err := p.location()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek() == "" || p.accept("h", "hold", "holds") {
+		return p.end(&SupportOrder{Position: position, Source: source, Destination: source}, nil)
+	}
+	if !p.moveArrow() {
+		return nil, p.fail(ErrUnexpectedToken)
+	}
+	destination, _, err := p.location()
+	if err != nil {
+		return nil, err
+	}
+	return p.end(&SupportOrder{Position: position, Source: source, Destination: destination}, nil)
+}
+
+func (p *orderParser) convoy(position *Province) (Order, error) {
+	p.unitType()
+	source, _, err := p.location()
+	if err != nil {
+		return nil, err
+	}
+	if !p.moveArrow() {
+		return nil, p.fail(ErrUnexpectedToken)
+	}
+	destination, _, err := p.location()
+	if err != nil {
+		return nil, err
+	}
+	return p.end(&ConvoyOrder{Position: position, Source: source, Destination: destination}, nil)
+}
+
+// dislodgedOrder parses the retreat or disband of a dislodged unit.
+func (p *orderParser) dislodgedOrder() (Order, error) {
+	typeToken := p.next
+	unitType, typed := p.unitType()
+	at := p.next
+	position, _, err := p.location()
+	if err != nil {
+		return nil, err
+	}
+
+	dislodged, _ := p.state.GetDislodgedUnit(position.Key)
+	if dislodged == nil {
+		p.next = at
+		return nil, p.fail(ErrNoDislodgedUnit)
+	}
+	if typed && dislodged.Unit.Type != unitType {
+		p.next = typeToken
+		return nil, p.fail(ErrWrongUnitType)
+	}
+
+	if p.accept("d", "disband", "disbands") {
+		return p.end(&DisbandOrder{Unit: dislodged.Unit, Position: position}, nil)
+	}
+	if !p.accept("r", "retreat", "retreats") && !p.moveArrow() {
+		return nil, p.fail(ErrUnexpectedToken)
+	}
+	p.moveArrow()
+
+	destination, coast, err := p.location()
+	if err != nil {
+		return nil, err
+	}
+	coast = destinationCoast(p.state.World, dislodged.Unit, position, destination, coast)
+	return p.end(&RetreatOrder{Unit: dislodged.Unit, Position: position, Destination: destination, Coast: coast}, nil)
+}
+
+// build parses a build written with the keyword first, e.g. "Build F Stp/nc".
+func (p *orderParser) build() (Order, error) {
+	unitType, typed := p.unitType()
+	if !typed {
+		return nil, p.fail(ErrUnexpectedToken)
+	}
+	position, coast, err := p.location()
+	if err != nil {
+		return nil, err
+	}
+	return p.end(p.buildOrder(unitType, position, coast), nil)
+}
+
+func (p *orderParser) buildOrder(unitType UnitType, position *Province, coast Coast) Order {
+	if unitType != Fleet {
+		coast = NoCoast
+	}
+	return &BuildOrder{Country: p.country, Type: unitType, Position: position, Coast: coast}
+}
@@ -0,0 +1,165 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOrder_StandardNotation(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Austria", Army, "Vie"},
+		testUnit{"Austria", Fleet, "Tri"},
+		testUnit{"Italy", Fleet, "ION"},
+		testUnit{"Russia", Fleet, "Stp/sc"},
+	)
+
+	tests := []struct {
+		country string
+		text    string
+	}{
+		{"Austria", "A Vie H"},
+		{"Austria", "A Vie - Tri"},
+		{"Austria", "A Vie - Tri via Convoy"},
+		{"Austria", "F Tri S Ven - Tyr"},
+		{"Austria", "F Tri S Vie"},
+		{"Italy", "F ION C Apu - Tun"},
+		{"Russia", "F Stp/sc - BOT"},
+	}
+
+	for _, test := range tests {
+		order, err := s.ParseOrder(test.country, test.text)
+		if assert.NoError(t, err, test.text) {
+			assert.Equal(t, test.text, order.String())
+		}
+	}
+}
+
+func TestParseOrder_Variations(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Austria", Army, "Vie"},
+		testUnit{"Austria", Fleet, "Tri"},
+		testUnit{"France", Fleet, "MAO"},
+		testUnit{"Russia", Fleet, "Stp/nc"},
+	)
+
+	tests := []struct {
+		country  string
+		text     string
+		expected string
+	}{
+		{"Austria", "Vie", "A Vie H"},
+		{"Austria", "a vie holds", "A Vie H"},
+		{"Austria", "A Vienna -> Trieste", "A Vie - Tri"},
+		{"Austria", "Army Vie-Tri", "A Vie - Tri"},
+		{"Austria", "A Vie to Bohemia", "A Vie - Boh"},
+		{"France", "F Mid-Atlantic Ocean - Spa(nc)", "F MAO - Spa/nc"},
+		{"France", "fleet mao supports a par - bre", "F MAO S Par - Bre"},
+		{"France", "F MAO - Spain south coast", "F MAO - Spa/sc"},
+		{"France", "F MAO convoys A Bre - Lon", "F MAO C Bre - Lon"},
+		{"Austria", "F Tri S A Ven - Tyr", "F Tri S Ven - Tyr"},
+		{"Russia", "F St. Petersburg (nc) - Nwy", "F Stp/nc - Nwy"},
+	}
+
+	for _, test := range tests {
+		order, err := s.ParseOrder(test.country, test.text)
+		if assert.NoError(t, err, test.text) {
+			assert.Equal(t, test.expected, order.String(), test.text)
+		}
+	}
+}
+
+func TestParseOrder_InfersCoast(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"France", Fleet, "Gas"})
+
+	order, err := s.ParseOrder("France", "F Gas - Spa")
+	assert.NoError(t, err)
+	assert.Equal(t, NorthCoast, order.(*MoveOrder).Coast)
+}
+
+func TestParseOrder_Errors(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"Austria", Army, "Vie"}, testUnit{"Russia", Fleet, "Sev"})
+
+	tests := []struct {
+		text     string
+		token    string
+		offset   int
+		expected error
+	}{
+		{"A Vie - Xyz", "Xyz", 8, ErrProvinceNotFound},
+		{"A Vie -", "", 7, ErrIncompleteOrder},
+		{"A Vie jumps Tri", "jumps", 6, ErrUnexpectedToken},
+		{"A Vie - Tri now", "now", 12, ErrUnexpectedToken},
+		{"F Vie - Tri", "F", 0, ErrWrongUnitType},
+		{"A Bud - Vie", "Bud", 2, ErrNoUnit},
+		{"A Vie - Spa/ec", "/ec", 11, ErrCoastNotFound},
+		{"A Vie S A Boh Tyr", "Tyr", 14, ErrUnexpectedToken},
+	}
+
+	for _, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			_, err := s.ParseOrder("Austria", test.text)
+			assert.ErrorIs(t, err, ErrInvalidNotation)
+			assert.ErrorIs(t, err, test.expected)
+
+			var parseErr *ParseError
+			if assert.ErrorAs(t, err, &parseErr) {
+				assert.Equal(t, test.token, parseErr.Token)
+				assert.Equal(t, test.offset, parseErr.Offset)
+			}
+		})
+	}
+}
+
+func TestParseOrder_ErrorMessage(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"Austria", Army, "Vie"})
+
+	_, err := s.ParseOrder("Austria", "A Vie - Xyz")
+	assert.EqualError(t, err, "Cannot parse 'A Vie - Xyz' at 'Xyz' (position 8): Province not found")
+
+	_, err = s.ParseOrder("Prussia", "A Vie H")
+	assert.ErrorIs(t, err, ErrCountryNotFound)
+}
+
+func TestParseOrder_RetreatsAndBuilds(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"Austria", Army, "Tri"})
+	unit := s.World.Provinces["Tri"].Unit
+	s.World.Provinces["Tri"].Unit = nil
+	s.Phase = RetreatPhase
+	s.Dislodged = []*DislodgedUnit{{Unit: unit, Position: s.World.Provinces["Tri"]}}
+
+	order, err := s.ParseOrder("Austria", "A Tri R Alb")
+	assert.NoError(t, err)
+	assert.Equal(t, "A Tri R Alb", order.String())
+
+	order, err = s.ParseOrder("Austria", "A Tri disband")
+	assert.NoError(t, err)
+	assert.Equal(t, "A Tri D", order.String())
+
+	_, err = s.ParseOrder("Austria", "A Vie R Boh")
+	assert.ErrorIs(t, err, ErrNoDislodgedUnit)
+
+	s.Phase = BuildPhase
+	order, err = s.ParseOrder("Russia", "Build F Stp/nc")
+	assert.NoError(t, err)
+	assert.Equal(t, "F Stp/nc B", order.String())
+
+	order, err = s.ParseOrder("Russia", "A Mos B")
+	assert.NoError(t, err)
+	assert.Equal(t, "A Mos B", order.String())
+}
+
+func TestAddOrder_ValidatesParsedOrder(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"Austria", Army, "Vie"})
+
+	order, err := s.ParseOrder("Austria", "A Vie - Tri")
+	assert.NoError(t, err)
+	assert.NoError(t, s.AddOrder("Austria", order))
+	assert.Equal(t, order.String(), s.World.Provinces["Vie"].Unit.Order.String())
+
+	assert.ErrorIs(t, s.AddOrder("Italy", order), ErrNotYourUnit)
+
+	order, err = s.ParseOrder("Austria", "A Vie - Ven")
+	assert.NoError(t, err)
+	assert.ErrorIs(t, s.AddOrder("Austria", order), ErrNotAdjacent)
+}
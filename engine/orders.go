@@ -1,7 +1,6 @@
 package engine
 
 import (
-	"errors"
 	"fmt"
 )
 
@@ -19,6 +18,8 @@ type HoldOrder struct {
 type MoveOrder struct {
 	Position    *Province
 	Destination *Province
+	Coast       Coast
+	ViaConvoy   bool
 }
 
 type SupportOrder struct {
@@ -34,7 +35,7 @@ type ConvoyOrder struct {
 }
 
 func (h HoldOrder) String() string {
-	return fmt.Sprintf("%s %s H", h.Position.Unit.Type, h.Position.Key)
+	return fmt.Sprintf("%s %s H", h.Position.Unit.Type, unitLocation(h.Position))
 }
 
 func (h HoldOrder) GetPosition() *Province {
@@ -50,7 +51,10 @@ func (h HoldOrder) GetDestination() *Province {
 }
 
 func (m MoveOrder) String() string {
-	return fmt.Sprintf("%s %s - %s", m.Position.Unit.Type, m.Position.Key, m.Destination.Key)
+	if m.ViaConvoy {
+		return fmt.Sprintf("%s %s - %s via Convoy", m.Position.Unit.Type, unitLocation(m.Position), location(m.Destination.Key, m.Coast))
+	}
+	return fmt.Sprintf("%s %s - %s", m.Position.Unit.Type, unitLocation(m.Position), location(m.Destination.Key, m.Coast))
 }
 
 func (m MoveOrder) GetPosition() *Province {
@@ -65,20 +69,11 @@ func (m MoveOrder) GetDestination() *Province {
 	return m.Destination
 }
 
-func (m MoveOrder) Move() error {
-	if m.Destination.Unit != nil {
-		return errors.New("Destination occupied")
-	}
-	m.Destination.Unit = m.Position.Unit
-	m.Position.Unit = nil
-	return nil
-}
-
 func (s SupportOrder) String() string {
 	if s.Source == s.Destination {
-		return fmt.Sprintf("%s %s S %s", s.Position.Unit.Type, s.Position.Key, s.Source.Key)
+		return fmt.Sprintf("%s %s S %s", s.Position.Unit.Type, unitLocation(s.Position), s.Source.Key)
 	}
-	return fmt.Sprintf("%s %s S %s - %s", s.Position.Unit.Type, s.Position.Key, s.Source.Key, s.Destination.Key)
+	return fmt.Sprintf("%s %s S %s - %s", s.Position.Unit.Type, unitLocation(s.Position), s.Source.Key, s.Destination.Key)
 }
 
 func (s SupportOrder) GetPosition() *Province {
@@ -94,7 +89,7 @@ func (s SupportOrder) GetDestination() *Province {
 }
 
 func (c ConvoyOrder) String() string {
-	return fmt.Sprintf("%s %s C %s - %s", c.Position.Unit.Type, c.Position.Key, c.Source.Key, c.Destination.Key)
+	return fmt.Sprintf("%s %s C %s - %s", c.Position.Unit.Type, unitLocation(c.Position), c.Source.Key, c.Destination.Key)
 }
 
 func (c ConvoyOrder) GetPosition() *Province {
@@ -109,6 +104,59 @@ func (c ConvoyOrder) GetDestination() *Province {
 	return c.Destination
 }
 
+type RetreatOrder struct {
+	Unit        *Unit
+	Position    *Province
+	Destination *Province
+	Coast       Coast
+}
+
+type DisbandOrder struct {
+	Unit     *Unit
+	Position *Province
+}
+
+func (r RetreatOrder) String() string {
+	return fmt.Sprintf("%s %s R %s", r.Unit.Type, location(r.Position.Key, r.Unit.Coast), location(r.Destination.Key, r.Coast))
+}
+
+func (r RetreatOrder) GetPosition() *Province {
+	return r.Position
+}
+
+func (r RetreatOrder) GetSource() *Province {
+	return r.Position
+}
+
+func (r RetreatOrder) GetDestination() *Province {
+	return r.Destination
+}
+
+func (d DisbandOrder) String() string {
+	return fmt.Sprintf("%s %s D", d.Unit.Type, location(d.Position.Key, d.Unit.Coast))
+}
+
+func (d DisbandOrder) GetPosition() *Province {
+	return d.Position
+}
+
+func (d DisbandOrder) GetSource() *Province {
+	return d.Position
+}
+
+func (d DisbandOrder) GetDestination() *Province {
+	return d.Position
+}
+
+// unitLocation names the position of the unit in a province including the
+// coast it is on, e.g. "Spa/nc".
+func unitLocation(p *Province) string {
+	if p.Unit == nil {
+		return p.Key
+	}
+	return location(p.Key, p.Unit.Coast)
+}
+
 func (u UnitType) String() string {
 	if u == Army {
 		return "A"
@@ -22,6 +22,23 @@ func TestMoveOrder_String(t *testing.T) {
 	assert.Equal(t, "F NY - CA", moveOrder.String(), "MoveOrder string should match expected format.")
 }
 
+func TestMoveOrder_StringViaConvoy(t *testing.T) {
+	usa := &Country{Name: "USA"}
+	unit := &Unit{Country: usa, Type: Army}
+	position := &Province{Unit: unit, Key: "NY", Name: "New York"}
+	dest := &Province{Key: "CA", Name: "California"}
+	moveOrder := MoveOrder{Position: position, Destination: dest, ViaConvoy: true}
+	assert.Equal(t, "A NY - CA via Convoy", moveOrder.String(), "MoveOrder via convoy string should match expected format.")
+}
+
+func TestMoveOrder_StringWithCoasts(t *testing.T) {
+	unit := &Unit{Country: &Country{Name: "Russia"}, Type: Fleet, Coast: SouthCoast}
+	position := &Province{Unit: unit, Key: "Stp", Name: "St. Petersburg"}
+	dest := &Province{Key: "Bul", Name: "Bulgaria"}
+	moveOrder := MoveOrder{Position: position, Destination: dest, Coast: EastCoast}
+	assert.Equal(t, "F Stp/sc - Bul/ec", moveOrder.String(), "MoveOrder string should include the coasts.")
+}
+
 func TestSupportOrder_String(t *testing.T) {
 	usa := &Country{Name: "USA"}
 	unit := &Unit{Country: usa, Type: Army}
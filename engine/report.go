@@ -0,0 +1,243 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OrderStatus is the outcome of an order after adjudication.
+type OrderStatus int8
+
+const (
+	Succeeded OrderStatus = iota
+	Bounced
+	Cut
+	Dislodged
+	Void
+	NoConvoy
+)
+
+func (o OrderStatus) String() string {
+	switch o {
+	case Succeeded:
+		return "Succeeded"
+	case Bounced:
+		return "Bounced"
+	case Cut:
+		return "Cut"
+	case Dislodged:
+		return "Dislodged"
+	case Void:
+		return "Void"
+	case NoConvoy:
+		return "NoConvoy"
+	}
+	return ""
+}
+
+// OrderResult describes how an order was resolved. In the movement phase the
+// strength is the attack strength of a move or the hold strength of any other
+// order, and the opposition is the strongest strength it was up against.
+// Text keeps the notation of the order from before the units were moved.
+type OrderResult struct {
+	Order      Order
+	Text       string
+	Country    string
+	Status     OrderStatus
+	Reason     string
+	Strength   int
+	Opposition int
+}
+
+func newOrderResult(order Order, country string) *OrderResult {
+	return &OrderResult{Order: order, Text: order.String(), Country: country, Status: Succeeded}
+}
+
+func (r *OrderResult) String() string {
+	if r.Reason == "" {
+		return fmt.Sprintf("%s: %s", r.Text, r.Status)
+	}
+	return fmt.Sprintf("%s: %s (%s)", r.Text, r.Status, r.Reason)
+}
+
+// Report lists the results of all orders of an adjudicated phase, including
+// the holds and disbands of units that were not given an order.
+type Report struct {
+	Year    int
+	Turn    Turn
+	Phase   Phase
+	Results []*OrderResult
+	// Outcome is set if the game ended with the reported phase.
+	Outcome *Outcome
+}
+
+// Result returns the result of the order given in a province, or nil if
+// there is none.
+func (r *Report) Result(position string) *OrderResult {
+	for _, result := range r.Results {
+		if result.Order.GetPosition().Key == position {
+			return result
+		}
+	}
+	return nil
+}
+
+// Name returns the canonical name of the reported phase, e.g. "S1901M".
+func (r *Report) Name() string {
+	return PhaseName(r.Year, r.Turn, r.Phase)
+}
+
+func (r *Report) String() string {
+	lines := []string{fmt.Sprintf("%s %d %s phase (%s)", r.Turn, r.Year, r.Phase, r.Name())}
+	for _, result := range r.Results {
+		lines = append(lines, fmt.Sprintf("%s: %s", result.Country, result))
+	}
+	if r.Outcome != nil {
+		lines = append(lines, r.Outcome.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// results reports the outcome of every order of the resolved movement phase.
+// It has to be called before any unit is moved.
+func (r *resolver) results() []*OrderResult {
+	results := []*OrderResult{}
+	for _, order := range r.orders {
+		position := order.GetPosition()
+
+		var result *OrderResult
+		if void, ok := r.void[position]; ok {
+			result = newOrderResult(void, "")
+			result.Status, result.Reason = Void, "Destination cannot be reached"
+			result.Strength, result.Opposition = r.holdStrength(position), r.strongestAttack(position)
+		} else {
+			result = r.orderResult(order)
+		}
+		if r.defaulted[order] {
+			result.Reason = "No order given"
+		}
+		if attack := r.dislodgedBy(position); attack != nil {
+			result.Status = Dislodged
+			result.Reason = fmt.Sprintf("Dislodged by %s", attack)
+		}
+
+		result.Country = position.Unit.Country.Name
+		results = append(results, result)
+	}
+	return results
+}
+
+func (r *resolver) orderResult(order Order) *OrderResult {
+	result := newOrderResult(order, "")
+	position := order.GetPosition()
+	result.Strength, result.Opposition = r.holdStrength(position), r.strongestAttack(position)
+
+	switch o := order.(type) {
+	case *MoveOrder:
+		result.Strength, result.Opposition = r.attackStrength(o), r.opposition(o)
+		switch {
+		case r.paradoxical[o]:
+			result.Status, result.Reason = NoConvoy, "Convoy paradox"
+		case !r.hasPath(o):
+			result.Status, result.Reason = NoConvoy, fmt.Sprintf("No convoy route to %s", o.Destination.Name)
+		case !r.resolve(o):
+			result.Status, result.Reason = Bounced, r.bounceReason(o)
+		}
+	case *SupportOrder:
+		if !r.supportMatches(o) {
+			result.Status, result.Reason = Void, "Supported unit was ordered otherwise"
+		} else if !r.resolve(o) {
+			result.Status, result.Reason = Cut, r.cutReason(o)
+		}
+	case *ConvoyOrder:
+		move, ok := r.orderAt[o.Source].(*MoveOrder)
+		if !ok || !isValidConvoyOrder(move, o) || !r.convoyed[move] {
+			result.Status, result.Reason = Void, "No matching convoyed move"
+		}
+	}
+
+	return result
+}
+
+// dislodgedBy returns the successful move that dislodged the unit in the
+// province, or nil if it stayed or moved away.
+func (r *resolver) dislodgedBy(province *Province) *MoveOrder {
+	if move, ok := r.orderAt[province].(*MoveOrder); ok && r.resolve(move) {
+		return nil
+	}
+	for _, move := range r.movesTo[province] {
+		if r.resolve(move) {
+			return move
+		}
+	}
+	return nil
+}
+
+func (r *resolver) strongestAttack(province *Province) int {
+	strongest := 0
+	for _, move := range r.movesTo[province] {
+		strongest = max(strongest, r.attackStrength(move))
+	}
+	return strongest
+}
+
+// opposition is the strongest strength a move has to overcome.
+func (r *resolver) opposition(move *MoveOrder) int {
+	opposition := r.holdStrength(move.Destination)
+	if opposing := r.headToHead(move); opposing != nil {
+		opposition = r.defendStrength(opposing)
+	}
+	for _, other := range r.movesTo[move.Destination] {
+		if other != move {
+			opposition = max(opposition, r.preventStrength(other))
+		}
+	}
+	return opposition
+}
+
+func (r *resolver) bounceReason(move *MoveOrder) string {
+	attack := r.attackStrength(move)
+	if opposing := r.headToHead(move); opposing != nil {
+		if attack <= r.defendStrength(opposing) {
+			return fmt.Sprintf("Lost head to head battle against %s", opposing)
+		}
+	} else if attack <= r.holdStrength(move.Destination) {
+		if defender := move.Destination.Unit; defender != nil && defender.Country == move.Position.Unit.Country {
+			return "Cannot dislodge own unit"
+		}
+		return fmt.Sprintf("Held in %s", move.Destination.Name)
+	}
+
+	for _, other := range r.movesTo[move.Destination] {
+		if other != move && attack <= r.preventStrength(other) {
+			return fmt.Sprintf("Standoff with %s", other)
+		}
+	}
+	return ""
+}
+
+// supportMatches checks that the supported unit carries out the supported
+// order. Holding, supporting and convoying units can be supported to hold.
+func (r *resolver) supportMatches(support *SupportOrder) bool {
+	supported, ok := r.orderAt[support.Source]
+	if !ok {
+		return false
+	}
+	if _, moving := supported.(*MoveOrder); !moving {
+		return support.Source == support.Destination
+	}
+	return support.Destination == supported.GetDestination()
+}
+
+func (r *resolver) cutReason(support *SupportOrder) string {
+	supporter := support.Position.Unit.Country
+	for _, attack := range r.movesTo[support.Position] {
+		if attack.Position.Unit.Country == supporter || !r.hasPath(attack) {
+			continue
+		}
+		if attack.Position != support.Destination || r.resolve(attack) {
+			return fmt.Sprintf("Cut by %s", attack)
+		}
+	}
+	return ""
+}
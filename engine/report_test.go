@@ -0,0 +1,131 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func assertResult(t *testing.T, report *Report, position string, status OrderStatus, reason string) {
+	result := report.Result(position)
+	if assert.NotNil(t, result, "Expected a result for %s", position) {
+		assert.Equal(t, status, result.Status, "Unexpected status of %s", result.Order)
+		assert.Equal(t, reason, result.Reason, "Unexpected reason for %s", result.Order)
+	}
+}
+
+func TestReport_MovementResults(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Austria", Army, "Vie"},
+		testUnit{"Austria", Army, "Bud"},
+		testUnit{"Italy", Army, "Ven"},
+		testUnit{"Italy", Army, "Tri"},
+		testUnit{"Germany", Army, "Mun"},
+		testUnit{"Russia", Army, "Gal"},
+		testUnit{"England", Army, "Lon"},
+	)
+	assert.NoError(t, s.AddMoveOrder("Austria", "Vie", "Tri"))
+	assert.NoError(t, s.AddSupportOrder("Austria", "Bud", "Vie", "Tri"))
+	assert.NoError(t, s.AddMoveOrder("Italy", "Ven", "Tyr"))
+	assert.NoError(t, s.AddMoveOrder("Germany", "Mun", "Tyr"))
+	assert.NoError(t, s.AddSupportOrder("Russia", "Gal", "Vie", "Boh"))
+	assert.NoError(t, s.AddConvoyedMoveOrder("England", "Lon", "Bel"))
+
+	report := adjudicate(t, s)
+
+	assert.Equal(t, "S1901M", report.Name())
+	assert.Len(t, report.Results, 7)
+	assertResult(t, report, "Vie", Succeeded, "")
+	assertResult(t, report, "Bud", Succeeded, "")
+	assertResult(t, report, "Tri", Dislodged, "Dislodged by A Vie - Tri")
+	assertResult(t, report, "Ven", Bounced, "Standoff with A Mun - Tyr")
+	assertResult(t, report, "Mun", Bounced, "Standoff with A Ven - Tyr")
+	assertResult(t, report, "Gal", Void, "Supported unit was ordered otherwise")
+	assertResult(t, report, "Lon", NoConvoy, "No convoy route to Belgium")
+
+	vienna := report.Result("Vie")
+	assert.Equal(t, "Austria", vienna.Country)
+	assert.Equal(t, 2, vienna.Strength)
+	assert.Equal(t, 1, vienna.Opposition)
+}
+
+func TestReport_CutSupport(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Austria", Army, "Vie"},
+		testUnit{"Austria", Army, "Bud"},
+		testUnit{"Russia", Army, "Rum"},
+	)
+	assert.NoError(t, s.AddMoveOrder("Austria", "Vie", "Gal"))
+	assert.NoError(t, s.AddSupportOrder("Austria", "Bud", "Vie", "Gal"))
+	assert.NoError(t, s.AddMoveOrder("Russia", "Rum", "Bud"))
+
+	report := adjudicate(t, s)
+
+	assertResult(t, report, "Bud", Cut, "Cut by A Rum - Bud")
+	assertResult(t, report, "Rum", Bounced, "Held in Budapest")
+	assertResult(t, report, "Vie", Succeeded, "")
+}
+
+func TestReport_IllegalAndMissingOrders(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Germany", Army, "Ber"},
+		testUnit{"Germany", Army, "Kie"},
+		testUnit{"Germany", Army, "Mun"},
+	)
+	addUncheckedOrder(s, "Germany", &MoveOrder{Position: s.World.Provinces["Ber"], Destination: s.World.Provinces["BAL"]})
+	assert.NoError(t, s.AddMoveOrder("Germany", "Kie", "Mun"))
+
+	report := adjudicate(t, s)
+
+	assertResult(t, report, "Ber", Void, "Destination cannot be reached")
+	assertResult(t, report, "Kie", Bounced, "Cannot dislodge own unit")
+	assertResult(t, report, "Mun", Succeeded, "No order given")
+	assert.Equal(t, "A Mun H", report.Result("Mun").Order.String())
+}
+
+func TestReport_RetreatResults(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Austria", Army, "Tri"},
+		testUnit{"Austria", Army, "Ser"},
+		testUnit{"Italy", Army, "Ven"},
+		testUnit{"Italy", Army, "Tyr"},
+		testUnit{"Russia", Army, "Rum"},
+		testUnit{"Russia", Army, "Bul"},
+	)
+	assert.NoError(t, s.AddMoveOrder("Italy", "Ven", "Tri"))
+	assert.NoError(t, s.AddSupportOrder("Italy", "Tyr", "Ven", "Tri"))
+	assert.NoError(t, s.AddMoveOrder("Russia", "Rum", "Ser"))
+	assert.NoError(t, s.AddSupportOrder("Russia", "Bul", "Rum", "Ser"))
+	adjudicate(t, s)
+
+	assert.NoError(t, s.AddRetreatOrder("Austria", "Tri", "Alb"))
+	assert.NoError(t, s.AddRetreatOrder("Austria", "Ser", "Alb"))
+	report := adjudicate(t, s)
+
+	assert.Equal(t, RetreatPhase, report.Phase)
+	assertResult(t, report, "Tri", Bounced, "Standoff in Albania")
+	assertResult(t, report, "Ser", Bounced, "Standoff in Albania")
+}
+
+func TestReport_CivilDisorder(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"Russia", Army, "Lvn"}, testUnit{"Russia", Army, "Mos"})
+	assert.NoError(t, s.SetOwner("Mos", "Russia"))
+	s.Turn = Winter
+	s.Phase = BuildPhase
+
+	report := adjudicate(t, s)
+
+	assert.Len(t, report.Results, 1)
+	assertResult(t, report, "Lvn", Succeeded, "Civil disorder")
+	assert.Equal(t, "Winter 1901 Build phase (W1901A)\nRussia: A Lvn D: Succeeded (Civil disorder)", report.String())
+}
+
+func TestReport_StringAfterUnitsMoved(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"Austria", Army, "Vie"})
+	assert.NoError(t, s.AddMoveOrder("Austria", "Vie", "Tri"))
+
+	report := adjudicate(t, s)
+
+	assert.Equal(t, "A Vie - Tri", report.Result("Vie").Text)
+	assert.Equal(t, "Spring 1901 Order phase (S1901M)\nAustria: A Vie - Tri: Succeeded", report.String())
+}
@@ -0,0 +1,213 @@
+package engine
+
+import "fmt"
+
+// DislodgedUnit is a unit that was driven out of its province during the
+// movement phase and has to retreat or disband in the retreat phase.
+type DislodgedUnit struct {
+	Unit         *Unit
+	Position     *Province
+	AttackedFrom *Province
+	Retreats     []*Province
+}
+
+// CanRetreatTo reports whether the province is one of the legal retreat
+// destinations of the unit.
+func (d *DislodgedUnit) CanRetreatTo(province *Province) bool {
+	for _, p := range d.Retreats {
+		if p == province {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *State) GetDislodgedUnit(position string) (*DislodgedUnit, error) {
+	for _, d := range s.Dislodged {
+		if d.Position.Key == position {
+			return d, nil
+		}
+	}
+	return nil, &NoDislodgedUnitError{Key: position}
+}
+
+func (s *State) AddRetreatOrder(country, position, destination string) error {
+	c, err := s.GetCountry(country)
+	if err != nil {
+		return err
+	}
+
+	err = s.requirePhase(RetreatPhase)
+	if err != nil {
+		return err
+	}
+
+	pos, _, err := s.World.GetLocation(position)
+	if err != nil {
+		return err
+	}
+
+	dislodged, err := s.GetDislodgedUnit(pos.Key)
+	if err != nil {
+		return err
+	}
+
+	dest, coast, err := s.World.GetLocation(destination)
+	if err != nil {
+		return err
+	}
+	coast = destinationCoast(s.World, dislodged.Unit, dislodged.Position, dest, coast)
+
+	order := &RetreatOrder{Unit: dislodged.Unit, Position: dislodged.Position, Destination: dest, Coast: coast}
+	if dislodged.Unit.Country == c {
+		err = s.validateRetreat(dislodged, order)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.addDislodgedOrder(c, dislodged, order)
+}
+
+// validateRetreat checks that the destination is one of the retreat options
+// of the dislodged unit and that the unit can reach it.
+func (s *State) validateRetreat(dislodged *DislodgedUnit, order *RetreatOrder) error {
+	reject := func(reason error) error {
+		return &IllegalMoveError{Order: order, Reason: reason}
+	}
+
+	unit, dest := dislodged.Unit, order.Destination
+	if unit.Type == Fleet && len(dest.Coasts) > 0 && order.Coast == NoCoast &&
+		len(s.World.ReachableCoasts(dislodged.Position, unit.Coast, dest)) > 1 {
+		return reject(ErrCoastRequired)
+	}
+	if dest == dislodged.Position || !s.World.canMoveTo(unit, dislodged.Position, dest, order.Coast) {
+		return reject(ErrNotAdjacent)
+	}
+	if dest.Unit != nil {
+		return reject(ErrProvinceOccupied)
+	}
+	if dest == dislodged.AttackedFrom {
+		return reject(ErrAttackerOrigin)
+	}
+	if !dislodged.CanRetreatTo(dest) {
+		return reject(ErrStandoff)
+	}
+	return nil
+}
+
+// AddDisbandOrder disbands a dislodged unit in the retreat phase, or a unit on
+// the board in the build phase.
+func (s *State) AddDisbandOrder(country, position string) error {
+	c, err := s.GetCountry(country)
+	if err != nil {
+		return err
+	}
+
+	err = s.requirePhase(RetreatPhase, BuildPhase)
+	if err != nil {
+		return err
+	}
+
+	if s.Phase == BuildPhase {
+		return s.addUnitDisbandOrder(c, position)
+	}
+
+	pos, _, err := s.World.GetLocation(position)
+	if err != nil {
+		return err
+	}
+
+	dislodged, err := s.GetDislodgedUnit(pos.Key)
+	if err != nil {
+		return err
+	}
+
+	return s.addDislodgedOrder(c, dislodged, &DisbandOrder{Unit: dislodged.Unit, Position: dislodged.Position})
+}
+
+func (s *State) addDislodgedOrder(country *Country, dislodged *DislodgedUnit, newOrder Order) error {
+	if dislodged.Unit.Country != country {
+		return &NotYourUnitError{Country: country.Name, Owner: dislodged.Unit.Country.Name}
+	}
+	if country.Ready {
+		return &OrdersLockedError{Country: country.Name}
+	}
+
+	dislodged.Unit.Order = newOrder
+
+	for index, existing := range country.orders {
+		if dislodged.Position.Key == existing.GetPosition().Key {
+			country.orders[index] = newOrder
+			return nil
+		}
+	}
+
+	country.orders = append(country.orders, newOrder)
+
+	return nil
+}
+
+// adjudicateRetreats moves every dislodged unit with a valid retreat order to
+// its destination. Units retreating to the same province, units with invalid
+// orders and units without orders are disbanded.
+func (s *State) adjudicateRetreats() ([]*OrderResult, error) {
+	targets := map[*Province]int{}
+	for _, d := range s.Dislodged {
+		if retreat, ok := d.Unit.Order.(*RetreatOrder); ok && s.isValidRetreat(d, retreat) {
+			targets[retreat.Destination]++
+		}
+	}
+
+	results := []*OrderResult{}
+	for _, d := range s.Dislodged {
+		order := d.Unit.Order
+		if order == nil {
+			order = &DisbandOrder{Unit: d.Unit, Position: d.Position}
+		}
+		result := newOrderResult(order, d.Unit.Country.Name)
+		results = append(results, result)
+
+		retreat, ok := d.Unit.Order.(*RetreatOrder)
+		switch {
+		case ok && s.isValidRetreat(d, retreat) && targets[retreat.Destination] == 1:
+			s.logger().Info("Unit retreated", "order", retreat)
+			d.Unit.Coast = retreat.Coast
+			retreat.Destination.Unit = d.Unit
+			continue
+		case ok && s.isValidRetreat(d, retreat):
+			result.Status, result.Reason = Bounced, fmt.Sprintf("Standoff in %s", retreat.Destination.Name)
+		case ok:
+			result.Status, result.Reason = Void, fmt.Sprintf("Cannot retreat to %s", retreat.Destination.Name)
+		case d.Unit.Order == nil:
+			result.Reason = "No order given"
+		}
+		s.logger().Info("Unit disbanded", "country", d.Unit.Country.Name, "province", d.Position.Key)
+	}
+
+	s.Dislodged = nil
+
+	return results, nil
+}
+
+func (s *State) isValidRetreat(dislodged *DislodgedUnit, retreat *RetreatOrder) bool {
+	return dislodged.CanRetreatTo(retreat.Destination) && s.World.canMoveTo(dislodged.Unit, dislodged.Position, retreat.Destination, retreat.Coast)
+}
+
+// retreatOptions lists the provinces a dislodged unit may retreat to: adjacent
+// provinces that are empty, were not the origin of the attack and were not
+// left empty by a standoff.
+func retreatOptions(world *Graph, dislodged *DislodgedUnit, standoffs map[*Province]bool) []*Province {
+	options := []*Province{}
+	for _, key := range sortedEdgeKeys(dislodged.Position) {
+		p := dislodged.Position.Edges[key].Province
+		if p.Unit != nil || p == dislodged.AttackedFrom || standoffs[p] {
+			continue
+		}
+		if !world.CanMoveCoast(dislodged.Unit.Type, dislodged.Position, dislodged.Unit.Coast, p, NoCoast) {
+			continue
+		}
+		options = append(options, p)
+	}
+	return options
+}
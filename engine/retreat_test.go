@@ -0,0 +1,229 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func provinceKeys(provinces []*Province) []string {
+	keys := []string{}
+	for _, p := range provinces {
+		keys = append(keys, p.Key)
+	}
+	return keys
+}
+
+// setupDislodgement dislodges the Italian army in Trieste, while a Russian
+// and a Turkish army stand off in Serbia.
+func setupDislodgement(t *testing.T) *State {
+	s := setupAdjudicationState(
+		testUnit{"Austria", Army, "Vie"},
+		testUnit{"Austria", Army, "Bud"},
+		testUnit{"Italy", Army, "Tri"},
+		testUnit{"Italy", Army, "Ven"},
+		testUnit{"Russia", Army, "Rum"},
+		testUnit{"Turkey", Army, "Bul"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("Austria", "Vie", "Tri"))
+	assert.NoError(t, s.AddSupportOrder("Austria", "Bud", "Vie", "Tri"))
+	assert.NoError(t, s.AddMoveOrder("Russia", "Rum", "Ser"))
+	assert.NoError(t, s.AddMoveOrder("Turkey", "Bul", "Ser"))
+
+	adjudicate(t, s)
+
+	return s
+}
+
+func TestAdjudicate_RecordsDislodgedUnits(t *testing.T) {
+	s := setupDislodgement(t)
+
+	assert.Equal(t, Spring, s.Turn)
+	assert.Equal(t, RetreatPhase, s.Phase)
+	assert.Len(t, s.Dislodged, 1)
+
+	dislodged := s.Dislodged[0]
+	assert.Equal(t, "Italy", dislodged.Unit.Country.Name)
+	assert.Equal(t, "Tri", dislodged.Position.Key)
+	assert.Equal(t, "Vie", dislodged.AttackedFrom.Key)
+	assertUnitAt(t, s, "Tri", "Austria", Army)
+
+	// not Vienna (origin of the attack), Budapest and Venice (occupied),
+	// Serbia (standoff) or the Adriatic Sea (water)
+	assert.Equal(t, []string{"Alb", "Tyr"}, provinceKeys(dislodged.Retreats))
+}
+
+func TestAdjudicate_SkipsRetreatPhaseWithoutDislodgement(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"Germany", Army, "Mun"})
+
+	assert.NoError(t, s.AddMoveOrder("Germany", "Mun", "Boh"))
+	adjudicate(t, s)
+
+	assert.Empty(t, s.Dislodged)
+	assert.Equal(t, Fall, s.Turn)
+	assert.Equal(t, OrderPhase, s.Phase)
+}
+
+func TestAdjudicate_AttackByConvoyAllowsRetreatToOrigin(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"England", Army, "Lon"},
+		testUnit{"England", Fleet, "NTH"},
+		testUnit{"England", Fleet, "SKA"},
+		testUnit{"Russia", Army, "Nwy"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("England", "Lon", "Nwy"))
+	assert.NoError(t, s.AddConvoyOrder("England", "NTH", "Lon", "Nwy"))
+	assert.NoError(t, s.AddSupportOrder("England", "SKA", "Lon", "Nwy"))
+
+	adjudicate(t, s)
+
+	assert.Len(t, s.Dislodged, 1)
+	assert.Nil(t, s.Dislodged[0].AttackedFrom)
+	assert.Equal(t, []string{"Fin", "Stp", "Swe"}, provinceKeys(s.Dislodged[0].Retreats))
+}
+
+func TestAddRetreatOrder_NoDislodgedUnit(t *testing.T) {
+	s := setupDislodgement(t)
+	err := s.AddRetreatOrder("Austria", "Vie", "Boh")
+	assert.EqualError(t, err, "No dislodged unit in 'Vie'")
+	assert.ErrorIs(t, err, ErrNoDislodgedUnit)
+}
+
+func TestAddRetreatOrder_UnitOfOtherCountry(t *testing.T) {
+	s := setupDislodgement(t)
+	err := s.AddRetreatOrder("Austria", "Tri", "Tyr")
+	assert.ErrorIs(t, err, ErrNotYourUnit)
+}
+
+func TestAddDisbandOrder_ValidInputs(t *testing.T) {
+	s := setupDislodgement(t)
+	err := s.AddDisbandOrder("Italy", "Tri")
+	assert.NoError(t, err)
+
+	italy, _ := s.GetCountry("Italy")
+	assert.IsType(t, &DisbandOrder{}, italy.orders[0], "Order should be a DisbandOrder")
+}
+
+func TestAdjudicate_Retreat(t *testing.T) {
+	s := setupDislodgement(t)
+
+	assert.NoError(t, s.AddRetreatOrder("Italy", "Tri", "Tyr"))
+	adjudicate(t, s)
+
+	assert.Empty(t, s.Dislodged)
+	assert.Equal(t, Fall, s.Turn)
+	assert.Equal(t, OrderPhase, s.Phase)
+	assertUnitAt(t, s, "Tyr", "Italy", Army)
+	assertUnitAt(t, s, "Tri", "Austria", Army)
+}
+
+func TestAddRetreatOrder_IllegalDestinations(t *testing.T) {
+	tests := []struct {
+		name        string
+		destination string
+		expected    error
+	}{
+		{"Origin of attack", "Vie", ErrAttackerOrigin},
+		{"Standoff", "Ser", ErrStandoff},
+		{"Occupied", "Ven", ErrProvinceOccupied},
+		{"Not adjacent", "Mun", ErrNotAdjacent},
+		{"Army to sea", "ADR", ErrNotAdjacent},
+		{"Own province", "Tri", ErrNotAdjacent},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := setupDislodgement(t)
+
+			err := s.AddRetreatOrder("Italy", "Tri", test.destination)
+			assert.ErrorIs(t, err, test.expected)
+			assert.IsType(t, &IllegalMoveError{}, err)
+
+			italy, _ := s.GetCountry("Italy")
+			assert.Empty(t, italy.orders)
+		})
+	}
+}
+
+func TestAddRetreatOrder_IllegalDestinationKeepsValidRetreat(t *testing.T) {
+	s := setupDislodgement(t)
+	assert.NoError(t, s.Submit("Italy", "A Tri R Tyr"))
+
+	assert.ErrorIs(t, s.Submit("Italy", "A Tri R Ven"), ErrProvinceOccupied)
+	assert.Equal(t, "A Tri R Tyr", s.Dislodged[0].Unit.Order.String())
+
+	adjudicate(t, s)
+	assertUnitAt(t, s, "Tyr", "Italy", Army)
+}
+
+func TestAdjudicate_RetreatToIllegalProvinceDisbands(t *testing.T) {
+	s := setupDislodgement(t)
+	d := s.Dislodged[0]
+	// an illegal retreat that bypassed validation
+	d.Unit.Order = &RetreatOrder{Unit: d.Unit, Position: d.Position, Destination: s.World.Provinces["Vie"]}
+
+	report := adjudicate(t, s)
+
+	assert.Len(t, s.World.GetUnits("Italy"), 1)
+	assertUnitAt(t, s, "Ven", "Italy", Army)
+	assertResult(t, report, "Tri", Void, "Cannot retreat to Vienna")
+}
+
+func TestAdjudicate_DislodgedUnitWithoutOrderDisbands(t *testing.T) {
+	s := setupDislodgement(t)
+
+	adjudicate(t, s)
+
+	assert.Len(t, s.World.GetUnits("Italy"), 1)
+	assert.Equal(t, Fall, s.Turn)
+}
+
+func TestAdjudicate_RetreatsToSameProvinceDisband(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Austria", Army, "Vie"},
+		testUnit{"Austria", Army, "Bud"},
+		testUnit{"Italy", Army, "Tri"},
+		testUnit{"Germany", Army, "Mun"},
+		testUnit{"Germany", Army, "Boh"},
+		testUnit{"Russia", Army, "Tyr"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("Austria", "Vie", "Tri"))
+	assert.NoError(t, s.AddSupportOrder("Austria", "Bud", "Vie", "Tri"))
+	assert.NoError(t, s.AddMoveOrder("Germany", "Mun", "Tyr"))
+	assert.NoError(t, s.AddSupportOrder("Germany", "Boh", "Mun", "Tyr"))
+	adjudicate(t, s)
+	assert.Len(t, s.Dislodged, 2)
+
+	assert.NoError(t, s.AddRetreatOrder("Italy", "Tri", "Ven"))
+	assert.NoError(t, s.AddRetreatOrder("Russia", "Tyr", "Ven"))
+	adjudicate(t, s)
+
+	assertEmpty(t, s, "Ven")
+	assert.Empty(t, s.World.GetUnits("Italy"))
+	assert.Empty(t, s.World.GetUnits("Russia"))
+}
+
+func TestAdjudicate_FleetRetreatOptions(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"France", Army, "Bur"},
+		testUnit{"France", Army, "Pie"},
+		testUnit{"Italy", Fleet, "Mar"},
+	)
+
+	assert.NoError(t, s.AddMoveOrder("France", "Bur", "Mar"))
+	assert.NoError(t, s.AddSupportOrder("France", "Pie", "Bur", "Mar"))
+
+	adjudicate(t, s)
+
+	assert.Len(t, s.Dislodged, 1)
+	assert.Equal(t, []string{"LYO", "Spa"}, provinceKeys(s.Dislodged[0].Retreats))
+
+	assert.NoError(t, s.AddRetreatOrder("Italy", "Mar", "Spa"))
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Spa", "Italy", Fleet)
+	assert.Equal(t, SouthCoast, s.World.Provinces["Spa"].Unit.Coast)
+}
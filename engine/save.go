@@ -0,0 +1,351 @@
+package engine
+
+import "encoding/json"
+
+// savedState is the pointer-free form of a State. Units, provinces and
+// countries refer to each other by key and name, and pending orders are kept
+// in standard notation.
+type savedState struct {
+	Variant        string            `json:"variant,omitempty"`
+	VictoryCenters int               `json:"victoryCenters,omitempty"`
+	Year           int               `json:"year"`
+	Turn           Turn              `json:"turn"`
+	Phase          Phase             `json:"phase"`
+	ParadoxRule    string            `json:"paradoxRule"`
+	Map            *savedMap         `json:"map"`
+	Countries      []savedCountry    `json:"countries"`
+	Units          []UnitPosition    `json:"units"`
+	SupplyCenters  map[string]string `json:"supplyCenters"`
+	Dislodged      []savedDislodged  `json:"dislodged"`
+	Outcome        *Outcome          `json:"outcome,omitempty"`
+	DrawProposal   *DrawProposal     `json:"drawProposal,omitempty"`
+}
+
+type savedCountry struct {
+	Name            string   `json:"name"`
+	HomeCenters     []string `json:"homeCenters"`
+	Eliminated      bool     `json:"eliminated,omitempty"`
+	EliminationYear int      `json:"eliminationYear,omitempty"`
+	Ready           bool     `json:"ready,omitempty"`
+	Orders          []string `json:"orders"`
+}
+
+type savedDislodged struct {
+	UnitPosition
+	AttackedFrom string   `json:"attackedFrom,omitempty"`
+	Retreats     []string `json:"retreats"`
+}
+
+// savedMap is the pointer-free form of a Graph without units and owners.
+type savedMap struct {
+	Provinces []savedProvince `json:"provinces"`
+}
+
+type savedProvince struct {
+	ProvinceDefinition
+	Edges []savedEdge `json:"edges"`
+}
+
+type savedEdge struct {
+	To     string      `json:"to"`
+	Kind   string      `json:"kind"`
+	Coasts []CoastLink `json:"coasts,omitempty"`
+}
+
+var tileTypeNames = map[TileType]string{LandTile: "land", WaterTile: "water"}
+
+var moveKindNames = map[MoveKind]string{ArmyMove: "army", FleetMove: "fleet", AnyMove: "any"}
+
+var paradoxRuleNames = map[ParadoxRule]string{SzykmanRule: "szykman", AllHoldRule: "all-hold"}
+
+// lookupName finds the value of a name in one of the name tables above.
+func lookupName[T comparable](names map[T]string, kind, name string) (T, error) {
+	for value, n := range names {
+		if n == name {
+			return value, nil
+		}
+	}
+	var zero T
+	return zero, &UnknownValueError{Kind: kind, Value: name}
+}
+
+// MarshalJSON writes the state including its map, units, supply centers,
+// dislodged units and pending orders.
+func (s *State) MarshalJSON() ([]byte, error) {
+	saved := savedState{
+		Variant:        s.Variant,
+		VictoryCenters: s.VictoryCenters,
+		Year:           s.Year,
+		Turn:           s.Turn,
+		Phase:          s.Phase,
+		ParadoxRule:    paradoxRuleNames[s.ParadoxRule],
+		Map:            saveMap(s.World),
+		Countries:      []savedCountry{},
+		Units:          []UnitPosition{},
+		SupplyCenters:  map[string]string{},
+		Dislodged:      []savedDislodged{},
+		Outcome:        s.Outcome,
+		DrawProposal:   s.DrawProposal,
+	}
+
+	for _, c := range s.Countries {
+		if c == nil {
+			continue
+		}
+		country := savedCountry{Name: c.Name, HomeCenters: c.HomeCenters, Eliminated: c.Eliminated, EliminationYear: c.EliminationYear, Ready: c.Ready, Orders: []string{}}
+		if country.HomeCenters == nil {
+			country.HomeCenters = []string{}
+		}
+		for _, order := range c.orders {
+			if order != nil {
+				country.Orders = append(country.Orders, order.String())
+			}
+		}
+		saved.Countries = append(saved.Countries, country)
+	}
+
+	snapshot := s.Snapshot()
+	saved.Units = snapshot.Units
+	saved.SupplyCenters = snapshot.SupplyCenters
+
+	for i, d := range s.Dislodged {
+		dislodged := savedDislodged{UnitPosition: snapshot.Dislodged[i], Retreats: []string{}}
+		if d.AttackedFrom != nil {
+			dislodged.AttackedFrom = d.AttackedFrom.Key
+		}
+		for _, p := range d.Retreats {
+			dislodged.Retreats = append(dislodged.Retreats, p.Key)
+		}
+		saved.Dislodged = append(saved.Dislodged, dislodged)
+	}
+
+	return json.Marshal(saved)
+}
+
+// UnmarshalJSON replaces the state with one written by MarshalJSON. Pending
+// orders are validated again as they are added. The logger is kept.
+func (s *State) UnmarshalJSON(data []byte) error {
+	saved := savedState{}
+	err := json.Unmarshal(data, &saved)
+	if err != nil {
+		return err
+	}
+
+	paradoxRule, err := lookupName(paradoxRuleNames, "paradox rule", saved.ParadoxRule)
+	if err != nil {
+		return err
+	}
+
+	world, err := saved.Map.graph()
+	if err != nil {
+		return err
+	}
+
+	loaded := &State{
+		Variant:        saved.Variant,
+		VictoryCenters: saved.VictoryCenters,
+		Year:           saved.Year,
+		Turn:           saved.Turn,
+		Phase:          saved.Phase,
+		ParadoxRule:    paradoxRule,
+		Countries:      []*Country{},
+		World:          world,
+		Dislodged:      []*DislodgedUnit{},
+		Outcome:        saved.Outcome,
+		DrawProposal:   saved.DrawProposal,
+		Logger:         s.Logger,
+	}
+
+	for _, c := range saved.Countries {
+		loaded.Countries = append(loaded.Countries, &Country{Name: c.Name, HomeCenters: c.HomeCenters, Eliminated: c.Eliminated, EliminationYear: c.EliminationYear})
+	}
+
+	for _, u := range saved.Units {
+		country, err := loaded.GetCountry(u.Country)
+		if err != nil {
+			return err
+		}
+		_, err = world.AddUnit(country, u.Type, u.Location)
+		if err != nil {
+			return err
+		}
+	}
+
+	for key, owner := range saved.SupplyCenters {
+		err = loaded.SetOwner(key, owner)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, d := range saved.Dislodged {
+		dislodged, err := loaded.loadDislodged(d)
+		if err != nil {
+			return err
+		}
+		loaded.Dislodged = append(loaded.Dislodged, dislodged)
+	}
+
+	for _, c := range saved.Countries {
+		for _, text := range c.Orders {
+			order, err := loaded.ParseOrder(c.Name, text)
+			if err != nil {
+				return err
+			}
+			err = loaded.AddOrder(c.Name, order)
+			if err != nil {
+				return err
+			}
+		}
+		// lock the orders only once they are all added
+		country, _ := loaded.GetCountry(c.Name)
+		country.Ready = c.Ready
+	}
+
+	*s = *loaded
+	return nil
+}
+
+func (s *State) loadDislodged(saved savedDislodged) (*DislodgedUnit, error) {
+	country, err := s.GetCountry(saved.Country)
+	if err != nil {
+		return nil, err
+	}
+	position, coast, err := s.World.GetLocation(saved.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	dislodged := &DislodgedUnit{
+		Unit:     &Unit{Country: country, Type: saved.Type, Coast: coast},
+		Position: position,
+		Retreats: []*Province{},
+	}
+	if saved.AttackedFrom != "" {
+		dislodged.AttackedFrom, err = s.World.GetProvince(saved.AttackedFrom)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, key := range saved.Retreats {
+		p, err := s.World.GetProvince(key)
+		if err != nil {
+			return nil, err
+		}
+		dislodged.Retreats = append(dislodged.Retreats, p)
+	}
+	return dislodged, nil
+}
+
+func saveMap(g *Graph) *savedMap {
+	saved := &savedMap{Provinces: []savedProvince{}}
+	for _, key := range sortedProvinceKeys(g) {
+		p := g.Provinces[key]
+		province := savedProvince{
+			ProvinceDefinition: ProvinceDefinition{
+				Key:          p.Key,
+				Name:         p.Name,
+				Type:         tileTypeNames[p.Type],
+				SupplyCenter: p.IsSupplyCenter,
+				Coasts:       p.Coasts,
+			},
+			Edges: []savedEdge{},
+		}
+		for _, to := range sortedEdgeKeys(p) {
+			edge := p.Edges[to]
+			province.Edges = append(province.Edges, savedEdge{To: to, Kind: moveKindNames[edge.Kind], Coasts: edge.Coasts})
+		}
+		saved.Provinces = append(saved.Provinces, province)
+	}
+	return saved
+}
+
+// graph builds the map, adding all provinces before connecting them.
+func (m *savedMap) graph() (*Graph, error) {
+	g := &Graph{Provinces: map[string]*Province{}}
+	if m == nil {
+		return g, nil
+	}
+
+	for _, p := range m.Provinces {
+		tileType, err := lookupName(tileTypeNames, "tile type", p.Type)
+		if err != nil {
+			return nil, err
+		}
+		g.AddProvince(p.Key, p.Name, tileType, p.SupplyCenter)
+		g.AddCoasts(p.Key, p.Coasts...)
+	}
+
+	for _, p := range m.Provinces {
+		src := g.Provinces[p.Key]
+		for _, e := range p.Edges {
+			kind, err := lookupName(moveKindNames, "move kind", e.Kind)
+			if err != nil {
+				return nil, err
+			}
+			dest, err := g.GetProvince(e.To)
+			if err != nil {
+				return nil, err
+			}
+			src.Edges[dest.Key] = &Edge{Province: dest, Kind: kind, Coasts: e.Coasts}
+		}
+	}
+
+	return g, nil
+}
+
+func (u UnitType) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+func (u *UnitType) UnmarshalText(text []byte) error {
+	for _, unitType := range []UnitType{Army, Fleet} {
+		if unitType.String() == string(text) {
+			*u = unitType
+			return nil
+		}
+	}
+	return &UnknownValueError{Kind: "unit type", Value: string(text)}
+}
+
+func (t Turn) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t *Turn) UnmarshalText(text []byte) error {
+	for _, turn := range []Turn{Spring, Fall, Winter} {
+		if turn.String() == string(text) {
+			*t = turn
+			return nil
+		}
+	}
+	return &UnknownValueError{Kind: "turn", Value: string(text)}
+}
+
+func (o OutcomeKind) MarshalText() ([]byte, error) {
+	return []byte(o.String()), nil
+}
+
+func (o *OutcomeKind) UnmarshalText(text []byte) error {
+	for _, kind := range []OutcomeKind{Solo, Draw} {
+		if kind.String() == string(text) {
+			*o = kind
+			return nil
+		}
+	}
+	return &UnknownValueError{Kind: "outcome", Value: string(text)}
+}
+
+func (p Phase) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *Phase) UnmarshalText(text []byte) error {
+	for _, phase := range []Phase{OrderPhase, RetreatPhase, BuildPhase} {
+		if phase.String() == string(text) {
+			*p = phase
+			return nil
+		}
+	}
+	return &UnknownValueError{Kind: "phase", Value: string(text)}
+}
@@ -0,0 +1,97 @@
+package engine
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func roundTrip(t *testing.T, s *State) *State {
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+
+	loaded := &State{}
+	assert.NoError(t, json.Unmarshal(data, loaded))
+
+	again, err := json.Marshal(loaded)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(data), string(again))
+	return loaded
+}
+
+func TestState_JSONRoundTrip(t *testing.T) {
+	s, err := InitializeNewGame(StandardVariant)
+	assert.NoError(t, err)
+	s.ParadoxRule = AllHoldRule
+	assert.NoError(t, s.AddMoveOrder("Russia", "Stp", "BOT"))
+	assert.NoError(t, s.AddSupportOrder("Austria", "Bud", "Vie", "Gal"))
+	assert.NoError(t, s.AddConvoyedMoveOrder("England", "Lvp", "Nwy"))
+
+	loaded := roundTrip(t, s)
+
+	assert.Equal(t, "S1901M", loaded.PhaseName())
+	assert.Equal(t, AllHoldRule, loaded.ParadoxRule)
+	assert.Equal(t, s.Snapshot(), loaded.Snapshot())
+	assert.Equal(t, s.submittedOrders(), loaded.submittedOrders())
+	assert.Equal(t, []string{"Mos", "Sev", "War", "Stp"}, loaded.Countries[5].HomeCenters)
+
+	for key, p := range s.World.Provinces {
+		other := loaded.World.Provinces[key]
+		assert.Equal(t, p.Name, other.Name)
+		assert.Equal(t, p.Coasts, other.Coasts)
+		assert.Equal(t, sortedEdgeKeys(p), sortedEdgeKeys(other), key)
+		for to, edge := range p.Edges {
+			assert.Equal(t, edge.Kind, other.Edges[to].Kind, "%s - %s", key, to)
+			assert.Equal(t, edge.Coasts, other.Edges[to].Coasts, "%s - %s", key, to)
+			assert.Same(t, loaded.World.Provinces[to], other.Edges[to].Province)
+		}
+	}
+
+	stp := loaded.World.Provinces["Stp"].Unit
+	assert.Same(t, loaded.Countries[5], stp.Country)
+	assert.Equal(t, "F Stp/sc - BOT", stp.Order.String())
+}
+
+func TestState_JSONRoundTripAdjudicatesTheSame(t *testing.T) {
+	s := setupGameState()
+	assert.NoError(t, s.AddMoveOrder("Italy", "Ven", "Tri"))
+	assert.NoError(t, s.AddSupportOrder("Italy", "Tyr", "Ven", "Tri"))
+	adjudicate(t, s)
+
+	assert.NoError(t, s.AddRetreatOrder("Austria", "Tri", "Alb"))
+	loaded := roundTrip(t, s)
+
+	assert.Equal(t, "S1901R", loaded.PhaseName())
+	if assert.Len(t, loaded.Dislodged, 1) {
+		dislodged := loaded.Dislodged[0]
+		assert.Equal(t, "Ven", dislodged.AttackedFrom.Key)
+		assert.Equal(t, len(s.Dislodged[0].Retreats), len(dislodged.Retreats))
+		assert.True(t, dislodged.CanRetreatTo(loaded.World.Provinces["Alb"]))
+	}
+
+	assert.Equal(t, adjudicate(t, s).String(), adjudicate(t, loaded).String())
+	assert.Equal(t, s.Snapshot(), loaded.Snapshot())
+}
+
+func TestState_UnmarshalJSONErrors(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"Austria", Army, "Vie"})
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+
+	var saved map[string]any
+	assert.NoError(t, json.Unmarshal(data, &saved))
+	saved["turn"] = "Autumn"
+	data, err = json.Marshal(saved)
+	assert.NoError(t, err)
+
+	err = json.Unmarshal(data, &State{})
+	assert.ErrorIs(t, err, ErrUnknownValue)
+	assert.EqualError(t, err, "Unknown turn 'Autumn'")
+
+	saved["turn"] = "Spring"
+	saved["units"] = []any{map[string]any{"country": "Austria", "type": "A", "location": "Xyz"}}
+	data, err = json.Marshal(saved)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, json.Unmarshal(data, &State{}), ErrProvinceNotFound)
+}
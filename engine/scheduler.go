@@ -0,0 +1,206 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Clock tells the time to a Scheduler, so tests can control it.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// Schedule sets the time countries get for each type of phase. A phase
+// without a duration only ends once all countries are ready.
+type Schedule struct {
+	Order   time.Duration
+	Retreat time.Duration
+	Build   time.Duration
+	// Grace is added once to a deadline that passes while countries are
+	// still outstanding.
+	Grace time.Duration
+	// Extension is added to the deadline whenever a country asks for it, at
+	// most MaxExtensions times per phase.
+	Extension     time.Duration
+	MaxExtensions int
+}
+
+func (s Schedule) duration(phase Phase) time.Duration {
+	switch phase {
+	case OrderPhase:
+		return s.Order
+	case RetreatPhase:
+		return s.Retreat
+	case BuildPhase:
+		return s.Build
+	}
+	return 0
+}
+
+// Scheduler adjudicates the phases of a game when their deadline passes, or
+// earlier once every country is ready. The state of the game must only be
+// changed through the scheduler while it runs.
+type Scheduler struct {
+	Game     *Game
+	Schedule Schedule
+	// OnReport is called with the report of every phase the scheduler
+	// adjudicates.
+	OnReport func(*Report)
+
+	clock      Clock
+	mutex      sync.Mutex
+	wake       chan struct{}
+	deadline   time.Time
+	graceUsed  bool
+	extensions int
+}
+
+// NewScheduler starts the deadline of the current phase of the game. The
+// system clock is used if clock is nil.
+func NewScheduler(game *Game, schedule Schedule, clock Clock) *Scheduler {
+	if clock == nil {
+		clock = systemClock{}
+	}
+	s := &Scheduler{Game: game, Schedule: schedule, clock: clock, wake: make(chan struct{}, 1)}
+	s.startPhase()
+	return s
+}
+
+func (s *Scheduler) startPhase() {
+	s.deadline = time.Time{}
+	if d := s.Schedule.duration(s.Game.State.Phase); d > 0 {
+		s.deadline = s.clock.Now().Add(d)
+	}
+	s.graceUsed = false
+	s.extensions = 0
+}
+
+// Deadline returns the deadline of the current phase, which is zero if the
+// phase has none.
+func (s *Scheduler) Deadline() time.Time {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.deadline
+}
+
+// Submit replaces the orders of the country, see State.Submit.
+func (s *Scheduler) Submit(country string, orders ...string) error {
+	return s.update(func(state *State) error { return state.Submit(country, orders...) })
+}
+
+// Ready locks the orders of the country, which may end the phase early.
+func (s *Scheduler) Ready(country string) error {
+	return s.update(func(state *State) error { return state.Ready(country) })
+}
+
+// Unready unlocks the orders of the country.
+func (s *Scheduler) Unready(country string) error {
+	return s.update(func(state *State) error { return state.Unready(country) })
+}
+
+// Extend moves the deadline of the current phase back by the extension of
+// the schedule on behalf of a country that is still playing.
+func (s *Scheduler) Extend(country string) error {
+	return s.update(func(state *State) error {
+		_, err := state.survivor(country)
+		if err != nil {
+			return err
+		}
+		if s.deadline.IsZero() || s.extensions >= s.Schedule.MaxExtensions {
+			return ErrNoExtensions
+		}
+
+		s.extensions++
+		s.deadline = s.deadline.Add(s.Schedule.Extension)
+		state.logger().Info("Deadline extended", "country", country, "phase", state.PhaseName(), "deadline", s.deadline)
+		return nil
+	})
+}
+
+// update changes the state under the lock of the scheduler and wakes up Run
+// to check whether the phase can be adjudicated.
+func (s *Scheduler) update(change func(*State) error) error {
+	s.mutex.Lock()
+	err := change(s.Game.State)
+	s.mutex.Unlock()
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+	return err
+}
+
+// Tick adjudicates the current phase if every country is ready or its
+// deadline has passed. It returns nil if the phase goes on.
+func (s *Scheduler) Tick() (*Report, error) {
+	report, err := s.tick()
+	if report != nil && s.OnReport != nil {
+		s.OnReport(report)
+	}
+	return report, err
+}
+
+func (s *Scheduler) tick() (*Report, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	state := s.Game.State
+	if state.Finished() {
+		return nil, ErrGameFinished
+	}
+
+	outstanding := state.Outstanding()
+	if len(outstanding) > 0 {
+		if s.deadline.IsZero() || s.clock.Now().Before(s.deadline) {
+			return nil, nil
+		}
+		if s.Schedule.Grace > 0 && !s.graceUsed {
+			s.graceUsed = true
+			s.deadline = s.deadline.Add(s.Schedule.Grace)
+			state.logger().Info("Grace period started", "phase", state.PhaseName(), "outstanding", outstanding, "deadline", s.deadline)
+			return nil, nil
+		}
+	}
+
+	report, err := s.Game.Adjudicate()
+	if err != nil {
+		return nil, err
+	}
+	s.startPhase()
+	return report, nil
+}
+
+// Run adjudicates phases as they become due until the game is finished or
+// the context is cancelled.
+func (s *Scheduler) Run(ctx context.Context) error {
+	for {
+		_, err := s.Tick()
+		if errors.Is(err, ErrGameFinished) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var timeout <-chan time.Time
+		if deadline := s.Deadline(); !deadline.IsZero() {
+			timeout = s.clock.After(deadline.Sub(s.clock.Now()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.wake:
+		case <-timeout:
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package engine
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock only moves when it is advanced, firing the timers that are due.
+type fakeClock struct {
+	mutex  sync.Mutex
+	now    time.Time
+	timers []fakeTimer
+}
+
+type fakeTimer struct {
+	at time.Time
+	c  chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(1901, time.March, 1, 12, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	timer := fakeTimer{at: c.now.Add(d), c: make(chan time.Time, 1)}
+	if d <= 0 {
+		timer.c <- c.now
+	} else {
+		c.timers = append(c.timers, timer)
+	}
+	return timer.c
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.now = c.now.Add(d)
+	pending := []fakeTimer{}
+	for _, timer := range c.timers {
+		if timer.at.After(c.now) {
+			pending = append(pending, timer)
+		} else {
+			timer.c <- c.now
+		}
+	}
+	c.timers = pending
+}
+
+func (c *fakeClock) waiting() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return len(c.timers)
+}
+
+var testSchedule = Schedule{Order: 24 * time.Hour, Retreat: time.Hour, Build: time.Hour}
+
+func setupScheduler(schedule Schedule, units ...testUnit) (*Scheduler, *fakeClock) {
+	clock := newFakeClock()
+	return NewScheduler(NewGame(setupAdjudicationState(units...)), schedule, clock), clock
+}
+
+func TestScheduler_AdjudicatesAtDeadline(t *testing.T) {
+	s, clock := setupScheduler(testSchedule, testUnit{"Austria", Army, "Vie"})
+	start := clock.Now()
+	assert.Equal(t, start.Add(24*time.Hour), s.Deadline())
+	assert.NoError(t, s.Submit("Austria", "A Vie - Gal"))
+
+	clock.Advance(23 * time.Hour)
+	report, err := s.Tick()
+	assert.NoError(t, err)
+	assert.Nil(t, report)
+
+	clock.Advance(time.Hour)
+	report, err = s.Tick()
+	assert.NoError(t, err)
+	assert.Equal(t, "S1901M", report.Name())
+	assertUnitAt(t, s.Game.State, "Gal", "Austria", Army)
+
+	// without dislodged units the game goes on with the fall orders
+	assert.Equal(t, "F1901M", s.Game.State.PhaseName())
+	assert.Equal(t, clock.Now().Add(24*time.Hour), s.Deadline())
+	assert.Len(t, s.Game.History, 1)
+}
+
+func TestScheduler_AdjudicatesEarlyWhenAllReady(t *testing.T) {
+	s, _ := setupScheduler(testSchedule, testUnit{"Austria", Army, "Vie"}, testUnit{"Italy", Army, "Ven"})
+	assert.NoError(t, s.Ready("Austria"))
+
+	report, err := s.Tick()
+	assert.NoError(t, err)
+	assert.Nil(t, report)
+
+	assert.NoError(t, s.Ready("Italy"))
+	report, err = s.Tick()
+	assert.NoError(t, err)
+	assert.NotNil(t, report)
+	assert.Equal(t, "F1901M", s.Game.State.PhaseName())
+}
+
+func TestScheduler_WithoutDeadlineWaitsForReady(t *testing.T) {
+	s, clock := setupScheduler(Schedule{}, testUnit{"Austria", Army, "Vie"})
+	assert.True(t, s.Deadline().IsZero())
+
+	clock.Advance(24 * 365 * time.Hour)
+	report, err := s.Tick()
+	assert.NoError(t, err)
+	assert.Nil(t, report)
+
+	assert.NoError(t, s.Ready("Austria"))
+	report, err = s.Tick()
+	assert.NoError(t, err)
+	assert.NotNil(t, report)
+}
+
+func TestScheduler_GracePeriod(t *testing.T) {
+	schedule := testSchedule
+	schedule.Grace = 2 * time.Hour
+	s, clock := setupScheduler(schedule, testUnit{"Austria", Army, "Vie"})
+	deadline := s.Deadline()
+
+	clock.Advance(24 * time.Hour)
+	report, err := s.Tick()
+	assert.NoError(t, err)
+	assert.Nil(t, report)
+	assert.Equal(t, deadline.Add(2*time.Hour), s.Deadline())
+
+	// the grace period is only granted once
+	clock.Advance(2 * time.Hour)
+	report, err = s.Tick()
+	assert.NoError(t, err)
+	assert.NotNil(t, report)
+}
+
+func TestScheduler_Extend(t *testing.T) {
+	schedule := testSchedule
+	schedule.Extension = 12 * time.Hour
+	schedule.MaxExtensions = 1
+	s, _ := setupScheduler(schedule, testUnit{"Austria", Army, "Vie"})
+	deadline := s.Deadline()
+
+	assert.ErrorIs(t, s.Extend("Prussia"), ErrCountryNotFound)
+	assert.NoError(t, s.Extend("Austria"))
+	assert.Equal(t, deadline.Add(12*time.Hour), s.Deadline())
+	assert.ErrorIs(t, s.Extend("Austria"), ErrNoExtensions)
+
+	s, _ = setupScheduler(Schedule{Extension: time.Hour, MaxExtensions: 1}, testUnit{"Austria", Army, "Vie"})
+	assert.ErrorIs(t, s.Extend("Austria"), ErrNoExtensions)
+}
+
+func TestScheduler_FinishedGame(t *testing.T) {
+	s, _ := setupScheduler(testSchedule, testUnit{"Austria", Army, "Vie"})
+	s.Game.State.Outcome = &Outcome{Kind: Solo, Winner: "Austria"}
+
+	_, err := s.Tick()
+	assert.ErrorIs(t, err, ErrGameFinished)
+	assert.NoError(t, s.Run(context.Background()))
+}
+
+func TestScheduler_Run(t *testing.T) {
+	s, clock := setupScheduler(testSchedule, testUnit{"Austria", Army, "Vie"})
+	reports := make(chan *Report, 4)
+	s.OnReport = func(r *Report) { reports <- r }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- s.Run(ctx) }()
+
+	// readiness wakes the scheduler up before the deadline
+	assert.NoError(t, s.Ready("Austria"))
+	assert.Equal(t, "S1901M", (<-reports).Name())
+
+	// the deadline of the next phase passes
+	assert.Eventually(t, func() bool { return clock.waiting() > 0 }, time.Second, time.Millisecond)
+	clock.Advance(24 * time.Hour)
+	assert.Equal(t, "F1901M", (<-reports).Name())
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
@@ -1,10 +1,9 @@
 package engine
 
 import (
-	"errors"
 	"fmt"
-	"log"
-	"reflect"
+	"log/slog"
+	"strconv"
 )
 
 type Turn int8
@@ -22,17 +21,107 @@ const (
 	BuildPhase
 )
 
+func (t Turn) String() string {
+	switch t {
+	case Spring:
+		return "Spring"
+	case Fall:
+		return "Fall"
+	case Winter:
+		return "Winter"
+	}
+	return ""
+}
+
+func (p Phase) String() string {
+	switch p {
+	case OrderPhase:
+		return "Order"
+	case RetreatPhase:
+		return "Retreat"
+	case BuildPhase:
+		return "Build"
+	}
+	return ""
+}
+
+// PhaseName returns the canonical name of a phase, which is the first letter
+// of the turn, the year and M, R or A for the movement, retreat and
+// adjustment phase, e.g. "S1901M", "F1901R" or "W1901A". The name is empty
+// for an unknown turn or phase.
+func PhaseName(year int, turn Turn, phase Phase) string {
+	turnLetter, ok := turnLetters[turn]
+	if !ok {
+		return ""
+	}
+	phaseLetter, ok := phaseLetters[phase]
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%s%d%s", turnLetter, year, phaseLetter)
+}
+
+var turnLetters = map[Turn]string{Spring: "S", Fall: "F", Winter: "W"}
+
+var phaseLetters = map[Phase]string{OrderPhase: "M", RetreatPhase: "R", BuildPhase: "A"}
+
+// ParsePhaseName splits a canonical phase name into its year, turn and phase.
+func ParsePhaseName(name string) (int, Turn, Phase, error) {
+	invalid := &InvalidPhaseNameError{Name: name}
+	if len(name) < 3 {
+		return 0, Spring, OrderPhase, invalid
+	}
+
+	year, err := strconv.Atoi(name[1 : len(name)-1])
+	if err != nil || year < 1 {
+		return 0, Spring, OrderPhase, invalid
+	}
+
+	for _, turn := range []Turn{Spring, Fall, Winter} {
+		for _, phase := range []Phase{OrderPhase, RetreatPhase, BuildPhase} {
+			if (turn == Winter) != (phase == BuildPhase) {
+				continue
+			}
+			if PhaseName(year, turn, phase) == name {
+				return year, turn, phase, nil
+			}
+		}
+	}
+	return 0, Spring, OrderPhase, invalid
+}
+
 type Country struct {
 	Name        string
 	HomeCenters []string
-	orders      []Order
+	// Eliminated is set once the country has lost all its units and
+	// supply centers, in the winter of EliminationYear.
+	Eliminated      bool
+	EliminationYear int
+	// Ready is set once the country has locked its orders for the phase.
+	Ready  bool
+	orders []Order
 }
 
 type State struct {
-	Turn      Turn
-	Phase     Phase
-	Countries []*Country
-	World     *Graph
+	// Variant is the name of the variant the game is played in, and
+	// VictoryCenters the number of supply centers a country needs to win.
+	Variant        string
+	VictoryCenters int
+	Year           int
+	Turn           Turn
+	Phase          Phase
+	Countries      []*Country
+	World          *Graph
+	ParadoxRule    ParadoxRule
+	Dislodged      []*DislodgedUnit
+	// Outcome is set once the game has ended, after which no more orders
+	// are accepted.
+	Outcome      *Outcome
+	DrawProposal *DrawProposal
+	// Logger receives the progress of adjudications at info level and a
+	// trace of every decision of the resolver at debug level. Nothing is
+	// logged while it is nil.
+	Logger *slog.Logger
 }
 
 func (s *State) GetCountry(country string) (*Country, error) {
@@ -44,7 +133,7 @@ func (s *State) GetCountry(country string) (*Country, error) {
 			return c, nil
 		}
 	}
-	return nil, errors.New("Country does not exist")
+	return nil, &CountryNotFoundError{Name: country}
 }
 
 func (s *State) AddHoldOrder(country string, position string) error {
@@ -53,7 +142,7 @@ func (s *State) AddHoldOrder(country string, position string) error {
 		return err
 	}
 
-	pos, err := s.World.GetProvince(position)
+	pos, _, err := s.World.GetLocation(position)
 	if err != nil {
 		return err
 	}
@@ -72,16 +161,40 @@ func (s *State) AddMoveOrder(country, position, destination string) error {
 		return err
 	}
 
-	pos, err := s.World.GetProvince(position)
+	pos, _, err := s.World.GetLocation(position)
 	if err != nil {
 		return err
 	}
 
-	dest, err := s.World.GetProvince(destination)
+	dest, coast, err := s.World.GetLocation(destination)
 	if err != nil {
 		return err
 	}
-	err = s.addOrder(c, &MoveOrder{Position: pos, Destination: dest})
+	coast = destinationCoast(s.World, pos.Unit, pos, dest, coast)
+	err = s.addOrder(c, &MoveOrder{Position: pos, Destination: dest, Coast: coast})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *State) AddConvoyedMoveOrder(country, position, destination string) error {
+	c, err := s.GetCountry(country)
+	if err != nil {
+		return err
+	}
+
+	pos, _, err := s.World.GetLocation(position)
+	if err != nil {
+		return err
+	}
+
+	dest, _, err := s.World.GetLocation(destination)
+	if err != nil {
+		return err
+	}
+	err = s.addOrder(c, &MoveOrder{Position: pos, Destination: dest, ViaConvoy: true})
 	if err != nil {
 		return err
 	}
@@ -95,17 +208,17 @@ func (s *State) AddSupportOrder(country, position, source, destination string) e
 		return err
 	}
 
-	pos, err := s.World.GetProvince(position)
+	pos, _, err := s.World.GetLocation(position)
 	if err != nil {
 		return err
 	}
 
-	src, err := s.World.GetProvince(source)
+	src, _, err := s.World.GetLocation(source)
 	if err != nil {
 		return err
 	}
 
-	dest, err := s.World.GetProvince(destination)
+	dest, _, err := s.World.GetLocation(destination)
 	if err != nil {
 		return err
 	}
@@ -123,17 +236,17 @@ func (s *State) AddConvoyOrder(country, position, source, destination string) er
 		return err
 	}
 
-	pos, err := s.World.GetProvince(position)
+	pos, _, err := s.World.GetLocation(position)
 	if err != nil {
 		return err
 	}
 
-	src, err := s.World.GetProvince(source)
+	src, _, err := s.World.GetLocation(source)
 	if err != nil {
 		return err
 	}
 
-	dest, err := s.World.GetProvince(destination)
+	dest, _, err := s.World.GetLocation(destination)
 	if err != nil {
 		return err
 	}
@@ -145,14 +258,39 @@ func (s *State) AddConvoyOrder(country, position, source, destination string) er
 	return nil
 }
 
+// destinationCoast returns the coast a unit moves to. Armies do not occupy a
+// coast, while fleets moving to a province with several coasts may leave out
+// the coast if only one of them can be reached.
+func destinationCoast(world *Graph, unit *Unit, position, destination *Province, coast Coast) Coast {
+	if unit == nil || unit.Type != Fleet {
+		return NoCoast
+	}
+	if coast == NoCoast {
+		if coasts := world.ReachableCoasts(position, unit.Coast, destination); len(coasts) == 1 {
+			return coasts[0]
+		}
+	}
+	return coast
+}
+
 func (s *State) addOrder(country *Country, newOrder Order) error {
 	if country.orders == nil {
 		country.orders = []Order{}
 	}
 
 	position := newOrder.GetPosition()
-	if position.Unit.Country != country {
-		return errors.New(fmt.Sprintf("%s cannot add order to unit of %s", country.Name, position.Unit.Country.Name))
+	err := s.requirePhase(OrderPhase)
+	if err != nil {
+		return err
+	}
+
+	if country.Ready {
+		return &OrdersLockedError{Country: country.Name}
+	}
+
+	err = s.validateOrder(country, newOrder)
+	if err != nil {
+		return err
 	}
 
 	position.Unit.Order = newOrder
@@ -169,6 +307,11 @@ func (s *State) addOrder(country *Country, newOrder Order) error {
 	return nil
 }
 
+// PhaseName returns the canonical name of the current phase.
+func (s *State) PhaseName() string {
+	return PhaseName(s.Year, s.Turn, s.Phase)
+}
+
 func (s *State) nextPhase() error {
 	switch s.Turn {
 	case Spring, Fall:
@@ -184,85 +327,149 @@ func (s *State) nextPhase() error {
 			}
 		}
 	case Winter:
+		s.Year++
 		s.Turn = Spring
 		s.Phase = OrderPhase
 	default:
-		return errors.New("Unsupported Turn")
+		return ErrUnsupportedTurn
 	}
 	return nil
 }
 
-func (s *State) Adjudicate() error {
-	log.Println("Adjudication starting...")
-	log.Println("Collecting orders")
+// Adjudicate resolves the orders of the current phase, moves on to the next
+// phase and reports the result of every order.
+func (s *State) Adjudicate() (*Report, error) {
+	if s.Finished() {
+		return nil, ErrGameFinished
+	}
+	s.logger().Info("Adjudication starting", "phase", s.PhaseName())
+
+	report := &Report{Year: s.Year, Turn: s.Turn, Phase: s.Phase}
+	var err error
+	switch s.Phase {
+	case OrderPhase:
+		report.Results, err = s.adjudicateMovement()
+	case RetreatPhase:
+		report.Results, err = s.adjudicateRetreats()
+	case BuildPhase:
+		report.Results, err = s.adjudicateAdjustments()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Phase == BuildPhase {
+		s.evaluateOutcome()
+		report.Outcome = s.Outcome
+	}
+	s.clearOrders()
+	s.DrawProposal = nil
+
+	err = s.nextPhase()
+	if err != nil {
+		return nil, err
+	}
+
+	// without dislodged units there is nothing to retreat
+	if s.Phase == RetreatPhase && len(s.Dislodged) == 0 {
+		err = s.nextPhase()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if s.Turn == Winter {
+		s.updateSupplyCenters()
+	}
+
+	return report, nil
+}
+
+func (s *State) adjudicateMovement() ([]*OrderResult, error) {
 	orders := []Order{}
 	for _, country := range s.Countries {
 		if country == nil {
 			continue
 		}
-		log.Printf("Collecting %ss orders", country.Name)
+		s.logger().Debug("Collecting orders", "country", country.Name, "count", len(country.orders))
 		for _, order := range country.orders {
 			if order == nil {
 				continue
 			}
-			orders = append(orders, order)
+			switch order.(type) {
+			case *HoldOrder, *MoveOrder, *SupportOrder, *ConvoyOrder:
+				orders = append(orders, order)
+			default:
+				return nil, &UnsupportedOrderError{Order: order}
+			}
 		}
 	}
 
-	log.Printf("Processing %d orders in total", len(orders))
-	for _, order := range orders {
-		log.Printf("Processing order %s", order)
-		switch o := order.(type) {
-		case *HoldOrder:
-			log.Printf("%s", o)
-		case *MoveOrder:
-			log.Printf("%s", o)
-			err := o.Move()
-			if err != nil {
-				return err
+	s.logger().Info("Processing orders", "count", len(orders))
+	r := newResolver(s.World, orders, s.ParadoxRule, s.logger())
+	moves := r.resolveAll()
+	results := r.results()
+
+	// lift all moving units before placing them, so that units can follow
+	// each other and swap places in circular movements
+	units := make([]*Unit, len(moves))
+	for i, move := range moves {
+		units[i] = move.Position.Unit
+		units[i].Coast = move.Coast
+		move.Position.Unit = nil
+	}
+
+	s.Dislodged = []*DislodgedUnit{}
+	for i, move := range moves {
+		if unit := move.Destination.Unit; unit != nil {
+			s.logger().Info("Unit dislodged", "country", unit.Country.Name, "province", move.Destination.Key, "from", move.Position.Key)
+			dislodged := &DislodgedUnit{Unit: unit, Position: move.Destination}
+			// a unit may retreat to the origin of an attack by convoy
+			if !r.convoyed[move] {
+				dislodged.AttackedFrom = move.Position
 			}
-		default:
-			return errors.New(fmt.Sprintf("Type %s is not supported", reflect.TypeOf(o)))
+			s.Dislodged = append(s.Dislodged, dislodged)
 		}
+		move.Destination.Unit = units[i]
 	}
 
-	// return s.nextPhase()
-	return nil
-}
-
-func successfulOrder(order Order, world *Graph) bool {
-
-	province := order.GetDestination()
-
-	neighbors, err := world.GetNeighborsWithUnits(province)
-	if err != nil {
-		return false
+	// a dislodged unit does not contest the province it tried to move to
+	dislodgedFrom := map[*Province]bool{}
+	for _, dislodged := range s.Dislodged {
+		dislodgedFrom[dislodged.Position] = true
+	}
+	standoffs := map[*Province]bool{}
+	for _, move := range r.bounced() {
+		if move.Destination.Unit == nil && !dislodgedFrom[move.Position] {
+			standoffs[move.Destination] = true
+		}
+	}
+	for _, dislodged := range s.Dislodged {
+		dislodged.Retreats = retreatOptions(s.World, dislodged, standoffs)
 	}
 
-	log.Printf("Found %d neighbors with units on them", len(neighbors))
-
-	strength := calculateStrength(order, neighbors)
-	log.Printf("Strength %d", strength)
+	return results, nil
+}
 
-	return false
+// clearOrders removes the orders of all countries and unlocks them for the
+// next phase.
+func (s *State) clearOrders() {
+	for _, country := range s.Countries {
+		if country == nil {
+			continue
+		}
+		s.clearCountryOrders(country)
+		country.Ready = false
+	}
 }
 
+// calculateStrength returns the strength of an order, which is one for the
+// unit itself plus one for every neighboring unit validly supporting it.
 func calculateStrength(order Order, neighbors []*Province) int {
 	strength := 1
 	for _, n := range neighbors {
-		if support, ok := n.Unit.Order.(*SupportOrder); ok {
-			log.Printf("Found support order from %s (%s)", support.GetPosition().Name, n.Unit.Order)
-			if isValidSupportOrder(order, support, *n.Unit) {
-				strength++
-			}
-		}
-
-		if move, ok := n.Unit.Order.(*MoveOrder); ok {
-			log.Printf("Found move order from %s (%s)", move.GetPosition().Name, n.Unit.Order)
-			if isValidSupportOrder(order, move, *n.Unit) {
-				strength--
-			}
-
+		if support, ok := n.Unit.Order.(*SupportOrder); ok && isValidSupportOrder(order, support, *n.Unit) {
+			strength++
 		}
 	}
 
@@ -278,3 +485,11 @@ func isValidSupportOrder(order, support Order, supportUnit Unit) bool {
 
 	return sameSrcAndDest && (supportIsArmy && destIsLand || supportIsFleet)
 }
+
+func isValidConvoyOrder(move *MoveOrder, convoy *ConvoyOrder) bool {
+	sameSrcAndDest := convoy.Source == move.Position && convoy.Destination == move.Destination
+	convoyIsFleetAtSea := convoy.Position.Type == WaterTile && convoy.Position.Unit.Type == Fleet
+	moveIsArmy := move.Position.Unit.Type == Army
+
+	return sameSrcAndDest && convoyIsFleetAtSea && moveIsArmy
+}
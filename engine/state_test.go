@@ -25,7 +25,7 @@ func InitializeTestGame() (*State, error) {
 				return nil, err
 			}
 
-			p.OwnedBy = hc
+			p.OwnedBy = c
 		}
 	}
 
@@ -71,24 +71,78 @@ func TestNextPhase(t *testing.T) {
 		initialPhase  Phase
 		expectedTurn  Turn
 		expectedPhase Phase
+		expectedYear  int
 	}{
-		{"Spring Order to Spring Retreat", Spring, OrderPhase, Spring, RetreatPhase},
-		{"Spring Retreat to Fall Order", Spring, RetreatPhase, Fall, OrderPhase},
-		{"Fall Order to Fall Retreat", Fall, OrderPhase, Fall, RetreatPhase},
-		{"Fall Retreat to Winter Build", Fall, RetreatPhase, Winter, BuildPhase},
-		{"Winter Build to Spring Order", Winter, BuildPhase, Spring, OrderPhase},
+		{"Spring Order to Spring Retreat", Spring, OrderPhase, Spring, RetreatPhase, 1901},
+		{"Spring Retreat to Fall Order", Spring, RetreatPhase, Fall, OrderPhase, 1901},
+		{"Fall Order to Fall Retreat", Fall, OrderPhase, Fall, RetreatPhase, 1901},
+		{"Fall Retreat to Winter Build", Fall, RetreatPhase, Winter, BuildPhase, 1901},
+		{"Winter Build to Spring Order", Winter, BuildPhase, Spring, OrderPhase, 1902},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			state := State{Turn: test.initialTurn, Phase: test.initialPhase}
+			state := State{Year: 1901, Turn: test.initialTurn, Phase: test.initialPhase}
 			state.nextPhase()
 			assert.Equal(t, test.expectedTurn, state.Turn, "Turn should transition correctly in "+test.name)
 			assert.Equal(t, test.expectedPhase, state.Phase, "Phase should transition correctly in "+test.name)
+			assert.Equal(t, test.expectedYear, state.Year, "Year should transition correctly in "+test.name)
+		})
+	}
+}
+
+func TestPhaseName(t *testing.T) {
+	tests := []struct {
+		name  string
+		year  int
+		turn  Turn
+		phase Phase
+	}{
+		{"S1901M", 1901, Spring, OrderPhase},
+		{"S1901R", 1901, Spring, RetreatPhase},
+		{"F1910M", 1910, Fall, OrderPhase},
+		{"F1910R", 1910, Fall, RetreatPhase},
+		{"W1901A", 1901, Winter, BuildPhase},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.name, PhaseName(test.year, test.turn, test.phase))
+
+			year, turn, phase, err := ParsePhaseName(test.name)
+			assert.NoError(t, err)
+			assert.Equal(t, test.year, year)
+			assert.Equal(t, test.turn, turn)
+			assert.Equal(t, test.phase, phase)
 		})
 	}
 }
 
+func TestPhaseName_Unknown(t *testing.T) {
+	assert.Equal(t, "", PhaseName(1901, Turn(7), OrderPhase))
+	assert.Equal(t, "", PhaseName(1901, Spring, Phase(7)))
+}
+
+func TestParsePhaseName_Invalid(t *testing.T) {
+	for _, name := range []string{"", "S1901", "X1901M", "S19O1M", "W1901M", "S1901A", "F-1901R", "s1901m"} {
+		_, _, _, err := ParsePhaseName(name)
+		assert.ErrorIs(t, err, ErrInvalidPhaseName, name)
+	}
+}
+
+func TestState_PhaseName(t *testing.T) {
+	s, err := InitializeNewGame(StandardVariant)
+	assert.NoError(t, err)
+	assert.Equal(t, "S1901M", s.PhaseName())
+
+	adjudicate(t, s)
+	assert.Equal(t, "F1901M", s.PhaseName())
+	adjudicate(t, s)
+	assert.Equal(t, "W1901A", s.PhaseName())
+	adjudicate(t, s)
+	assert.Equal(t, "S1902M", s.PhaseName())
+}
+
 func TestNextPhase_NegativeCases(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -108,18 +162,20 @@ func TestNextPhase_NegativeCases(t *testing.T) {
 }
 
 func setupStateWithGraph() *State {
-	provinces := map[string]*Province{
-		"Paris":     {Key: "Paris"},
-		"Berlin":    {Key: "Berlin"},
-		"Munich":    {Key: "Munich"},
-		"Edinburgh": {Key: "Edinburgh"},
-	}
+	graph := &Graph{Provinces: map[string]*Province{}}
+	graph.AddProvince("Paris", "Paris", LandTile, true)
+	graph.AddProvince("Berlin", "Berlin", LandTile, true)
+	graph.AddProvince("Munich", "Munich", LandTile, true)
+	graph.AddProvince("Edinburgh", "Edinburgh", WaterTile, true)
+
+	graph.AddEdges("Paris", []string{"Berlin", "Munich"})
+	graph.AddEdges("Berlin", []string{"Paris", "Munich"})
+	graph.AddEdges("Munich", []string{"Paris", "Berlin"})
 
 	france := &Country{Name: "France"}
 	germany := &Country{Name: "Germany"}
 	england := &Country{Name: "England"}
 
-	graph := &Graph{Provinces: provinces}
 	state := &State{
 		Turn:      Spring,
 		Phase:     OrderPhase,
@@ -189,7 +245,7 @@ func TestAdjudicate_HoldAndMoveOrders(t *testing.T) {
 	err = s.AddMoveOrder("Germany", "Berlin", "Munich")
 	assert.NoError(t, err, "AddMoveOrder should complete without errors")
 
-	err = s.Adjudicate()
+	_, err = s.Adjudicate()
 	assert.NoError(t, err, "Adjudicate should complete without error with orders")
 
 	par, err := s.World.GetProvince("Paris")
@@ -298,7 +354,7 @@ func TestCalculateStrength_MoveOrderWithoutSupportFromOther(t *testing.T) {
 
 	state.AddMoveOrder("Austria", "Vie", "Tri")
 	state.AddSupportOrder("Austria", "Bud", "Vie", "Tri")
-	state.AddSupportOrder("Italy", "Ven", "Vie", "Bud")
+	state.AddSupportOrder("Italy", "Ven", "Vie", "Tyr")
 
 	austria, err := state.GetCountry("Austria")
 	assert.NoError(t, err)
@@ -426,22 +482,32 @@ func TestIsValidSupportOrder(t *testing.T) {
 	}
 }
 
-// func TestCalculateStrength_MoveOrderWithDisturbedSupport(t *testing.T) {
-// 	state, err := InitializeTestGame()
-// 	assert.NoError(t, err)
-//
-// 	state.AddMoveOrder("Austria", "Vie", "Tri")
-// 	state.AddSupportOrder("Austria", "Bud", "Vie", "Tri")
-// 	state.AddSupportOrder("Italy", "Ven", "Vie", "Tri")
-//
-// 	austria, err := state.GetCountry("Austria")
-// 	assert.NoError(t, err)
-//
-// 	italy, err := state.GetCountry("Italy")
-// 	assert.NoError(t, err)
-//
-// 	assert.Len(t, austria.orders, 2)
-// 	assert.Len(t, italy.orders, 1)
-// 	strength := calculateStrength(austria.orders[0], state.World)
-// 	assert.Equal(t, 1, strength)
-// }
+func TestCalculateStrength_MoveOrderWithDisturbedSupport(t *testing.T) {
+	state, err := InitializeTestGame()
+	assert.NoError(t, err)
+
+	turkey, err := state.GetCountry("Turkey")
+	assert.NoError(t, err)
+	_, err = state.World.AddUnit(turkey, Army, "Tyr")
+	assert.NoError(t, err)
+
+	state.AddMoveOrder("Austria", "Vie", "Tri")
+	state.AddSupportOrder("Austria", "Bud", "Vie", "Tri")
+	state.AddSupportOrder("Italy", "Ven", "Vie", "Tri")
+	state.AddMoveOrder("Turkey", "Tyr", "Ven")
+
+	austria, err := state.GetCountry("Austria")
+	assert.NoError(t, err)
+
+	italy, err := state.GetCountry("Italy")
+	assert.NoError(t, err)
+
+	assert.Len(t, austria.orders, 2)
+	assert.Len(t, italy.orders, 1)
+
+	orders := append(append([]Order{}, austria.orders...), italy.orders...)
+	orders = append(orders, turkey.orders...)
+	move := austria.orders[0].(*MoveOrder)
+	strength := newResolver(state.World, orders, SzykmanRule, discardLogger).attackStrength(move)
+	assert.Equal(t, 2, strength)
+}
@@ -0,0 +1,170 @@
+package engine
+
+import "sort"
+
+// Submit replaces the orders of the country with the given orders in
+// standard notation. Either all orders are accepted or the previous orders
+// are kept. Orders cannot be submitted while the country is ready.
+func (s *State) Submit(country string, orders ...string) error {
+	c, err := s.survivor(country)
+	if err != nil {
+		return err
+	}
+	if c.Ready {
+		return &OrdersLockedError{Country: c.Name}
+	}
+
+	previous, previousUnits := c.orders, s.unitOrders(c)
+	s.clearCountryOrders(c)
+	for _, text := range orders {
+		err = s.submitOrder(c, text)
+		if err != nil {
+			s.clearCountryOrders(c)
+			c.orders = previous
+			for unit, order := range previousUnits {
+				unit.Order = order
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// unitOrders maps the units of the country, including its dislodged units,
+// to their orders.
+func (s *State) unitOrders(c *Country) map[*Unit]Order {
+	orders := map[*Unit]Order{}
+	for _, p := range s.World.Provinces {
+		if p.Unit != nil && p.Unit.Country == c {
+			orders[p.Unit] = p.Unit.Order
+		}
+	}
+	for _, dislodged := range s.Dislodged {
+		if dislodged.Unit.Country == c {
+			orders[dislodged.Unit] = dislodged.Unit.Order
+		}
+	}
+	return orders
+}
+
+func (s *State) submitOrder(c *Country, text string) error {
+	order, err := s.ParseOrder(c.Name, text)
+	if err != nil {
+		return err
+	}
+	return s.AddOrder(c.Name, order)
+}
+
+// Ready locks the orders of the country for the current phase. Units left
+// without orders hold when the phase is adjudicated, see MissingOrders.
+func (s *State) Ready(country string) error {
+	c, err := s.survivor(country)
+	if err != nil {
+		return err
+	}
+	c.Ready = true
+	return nil
+}
+
+// Unready unlocks the orders of the country so they can be changed again.
+func (s *State) Unready(country string) error {
+	c, err := s.survivor(country)
+	if err != nil {
+		return err
+	}
+	c.Ready = false
+	return nil
+}
+
+// Orders lists the orders of the country in standard notation, ordered by
+// province.
+func (s *State) Orders(country string) ([]string, error) {
+	c, err := s.GetCountry(country)
+	if err != nil {
+		return nil, err
+	}
+
+	orders := []string{}
+	for _, order := range sortedOrders(c) {
+		orders = append(orders, order.String())
+	}
+	return orders, nil
+}
+
+// MissingOrders lists the provinces of the units of the country that have no
+// order yet, both in the order phase and for dislodged units in the retreat
+// phase.
+func (s *State) MissingOrders(country string) ([]string, error) {
+	c, err := s.GetCountry(country)
+	if err != nil {
+		return nil, err
+	}
+
+	missing := []string{}
+	switch s.Phase {
+	case OrderPhase:
+		for _, p := range s.World.Provinces {
+			if p.Unit != nil && p.Unit.Country == c && p.Unit.Order == nil {
+				missing = append(missing, p.Key)
+			}
+		}
+	case RetreatPhase:
+		for _, d := range s.Dislodged {
+			if d.Unit.Country == c && d.Unit.Order == nil {
+				missing = append(missing, d.Position.Key)
+			}
+		}
+	}
+	sort.Strings(missing)
+	return missing, nil
+}
+
+// Outstanding lists the surviving countries that have to act in the current
+// phase and are not ready yet. The phase can be adjudicated once the list is
+// empty.
+func (s *State) Outstanding() []string {
+	outstanding := []string{}
+	if s.Finished() {
+		return outstanding
+	}
+	for _, c := range s.Countries {
+		if c != nil && !c.Eliminated && !c.Ready && s.mustAct(c) {
+			outstanding = append(outstanding, c.Name)
+		}
+	}
+	return outstanding
+}
+
+// mustAct reports whether the country has units to order, dislodged units
+// to retreat or adjustments to make in the current phase.
+func (s *State) mustAct(c *Country) bool {
+	switch s.Phase {
+	case OrderPhase:
+		return len(s.World.GetUnits(c.Name)) > 0
+	case RetreatPhase:
+		for _, d := range s.Dislodged {
+			if d.Unit.Country == c {
+				return true
+			}
+		}
+	case BuildPhase:
+		return s.adjustment(c) != 0
+	}
+	return false
+}
+
+// clearCountryOrders removes the orders of the country, including the
+// orders of its dislodged units.
+func (s *State) clearCountryOrders(c *Country) {
+	c.orders = nil
+	for _, p := range s.World.Provinces {
+		if p.Unit != nil && p.Unit.Country == c {
+			p.Unit.Order = nil
+		}
+	}
+	for _, dislodged := range s.Dislodged {
+		if dislodged.Unit.Country == c {
+			dislodged.Unit.Order = nil
+		}
+	}
+}
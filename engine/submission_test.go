@@ -0,0 +1,190 @@
+package engine
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubmit(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Austria", Army, "Vie"},
+		testUnit{"Austria", Army, "Bud"},
+		testUnit{"Austria", Fleet, "Tri"},
+	)
+
+	assert.NoError(t, s.Submit("Austria", "A Vie - Gal", "A Bud S A Vie - Gal", "F Tri H"))
+	assert.Equal(t, []SubmittedOrder{
+		{"Austria", "A Bud S Vie - Gal"},
+		{"Austria", "F Tri H"},
+		{"Austria", "A Vie - Gal"},
+	}, s.submittedOrders())
+
+	orders, err := s.Orders("Austria")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"A Bud S Vie - Gal", "F Tri H", "A Vie - Gal"}, orders)
+
+	// a new submission replaces all previous orders
+	assert.NoError(t, s.Submit("Austria", "A Vie - Tyr"))
+	assert.Equal(t, []SubmittedOrder{{"Austria", "A Vie - Tyr"}}, s.submittedOrders())
+	assert.Nil(t, s.World.Provinces["Bud"].Unit.Order)
+}
+
+func TestSubmit_KeepsOrdersOnError(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"Austria", Army, "Vie"}, testUnit{"Austria", Army, "Bud"})
+	assert.NoError(t, s.Submit("Austria", "A Vie - Gal", "A Bud - Rum"))
+
+	assert.ErrorIs(t, s.Submit("Austria", "A Vie - Tyr", "A Bud - Mun"), ErrNotAdjacent)
+	assert.ErrorIs(t, s.Submit("Austria", "A Vie Tyr"), ErrInvalidNotation)
+	assert.ErrorIs(t, s.Submit("Prussia", "A Vie - Tyr"), ErrCountryNotFound)
+
+	assert.Equal(t, []SubmittedOrder{{"Austria", "A Bud - Rum"}, {"Austria", "A Vie - Gal"}}, s.submittedOrders())
+	assert.Equal(t, "A Vie - Gal", s.World.Provinces["Vie"].Unit.Order.String())
+}
+
+func TestSubmit_BadSecondOrderKeepsEarlierOrders(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Austria", Army, "Vie"},
+		testUnit{"Austria", Army, "Bud"},
+		testUnit{"Austria", Fleet, "Tri"},
+	)
+	assert.NoError(t, s.Submit("Austria", "A Vie - Gal", "A Bud S Vie - Gal"))
+	austria, _ := s.GetCountry("Austria")
+	orders := append([]Order{}, austria.orders...)
+
+	assert.ErrorIs(t, s.Submit("Austria", "F Tri - ADR", "A Bud - Mun"), ErrNotAdjacent)
+
+	assert.Equal(t, orders, austria.orders)
+	assert.Same(t, orders[0], s.World.Provinces["Vie"].Unit.Order)
+	assert.Same(t, orders[1], s.World.Provinces["Bud"].Unit.Order)
+	assert.Nil(t, s.World.Provinces["Tri"].Unit.Order)
+}
+
+func TestSubmit_KeepsAdjustmentsOnError(t *testing.T) {
+	s := setupBuildState(
+		map[string]string{"Vie": "Austria"},
+		testUnit{"Austria", Army, "Vie"},
+		testUnit{"Austria", Army, "Ser"},
+	)
+	assert.NoError(t, s.Submit("Austria", "A Ser D"))
+
+	assert.Error(t, s.Submit("Austria", "A Vie D", "A Bud D"))
+
+	orders, _ := s.Orders("Austria")
+	assert.Equal(t, []string{"A Ser D"}, orders)
+	assert.Nil(t, s.World.Provinces["Vie"].Unit.Order)
+	assert.Nil(t, s.World.Provinces["Ser"].Unit.Order)
+}
+
+func TestReady_LocksOrders(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"Austria", Army, "Vie"}, testUnit{"Italy", Army, "Ven"})
+	assert.NoError(t, s.Submit("Austria", "A Vie - Tyr"))
+	assert.NoError(t, s.Ready("Austria"))
+
+	assert.ErrorIs(t, s.Submit("Austria", "A Vie - Gal"), ErrOrdersLocked)
+	assert.ErrorIs(t, s.AddMoveOrder("Austria", "Vie", "Gal"), ErrOrdersLocked)
+	assert.NoError(t, s.AddHoldOrder("Italy", "Ven"))
+
+	assert.NoError(t, s.Unready("Austria"))
+	assert.NoError(t, s.Submit("Austria", "A Vie - Gal"))
+	assert.Equal(t, "A Vie - Gal", s.World.Provinces["Vie"].Unit.Order.String())
+}
+
+func TestReady_LocksRetreatsAndAdjustments(t *testing.T) {
+	s := setupDislodgement(t)
+	assert.Equal(t, []string{"Italy"}, s.Outstanding())
+	assert.NoError(t, s.Ready("Italy"))
+	assert.ErrorIs(t, s.AddDisbandOrder("Italy", "Tri"), ErrOrdersLocked)
+
+	s = setupBuildState(map[string]string{"Vie": "Austria"})
+	assert.NoError(t, s.Ready("Austria"))
+	assert.ErrorIs(t, s.AddBuildOrder("Austria", "Vie", Army), ErrOrdersLocked)
+}
+
+func TestMissingOrders(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Austria", Army, "Vie"},
+		testUnit{"Austria", Army, "Bud"},
+		testUnit{"Austria", Fleet, "Tri"},
+	)
+	assert.NoError(t, s.Submit("Austria", "A Vie - Gal"))
+
+	missing, err := s.MissingOrders("Austria")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Bud", "Tri"}, missing)
+
+	missing, err = s.MissingOrders("Italy")
+	assert.NoError(t, err)
+	assert.Empty(t, missing)
+
+	_, err = s.MissingOrders("Prussia")
+	assert.ErrorIs(t, err, ErrCountryNotFound)
+}
+
+func TestMissingOrders_DefaultToHold(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"Austria", Army, "Vie"}, testUnit{"Austria", Army, "Bud"})
+	assert.NoError(t, s.Submit("Austria", "A Vie - Gal"))
+	assert.NoError(t, s.Ready("Austria"))
+
+	report := adjudicate(t, s)
+
+	assertResult(t, report, "Bud", Succeeded, "No order given")
+	assert.Equal(t, "A Bud H", report.Result("Bud").Order.String())
+	assertUnitAt(t, s, "Bud", "Austria", Army)
+	assertUnitAt(t, s, "Gal", "Austria", Army)
+}
+
+func TestOutstanding(t *testing.T) {
+	s := setupAdjudicationState(
+		testUnit{"Austria", Army, "Vie"},
+		testUnit{"Italy", Army, "Ven"},
+		testUnit{"Russia", Army, "War"},
+	)
+	assert.Equal(t, []string{"Austria", "Italy", "Russia"}, s.Outstanding())
+
+	assert.NoError(t, s.Ready("Italy"))
+	assert.Equal(t, []string{"Austria", "Russia"}, s.Outstanding())
+
+	assert.NoError(t, s.Ready("Austria"))
+	assert.NoError(t, s.Ready("Russia"))
+	assert.Empty(t, s.Outstanding())
+
+	// the next phase unlocks all countries again
+	adjudicate(t, s)
+	assert.Equal(t, []string{"Austria", "Italy", "Russia"}, s.Outstanding())
+	assert.False(t, s.Countries[0].Ready)
+}
+
+func TestOutstanding_OnlyCountriesThatHaveToAct(t *testing.T) {
+	s := setupBuildState(
+		map[string]string{"Vie": "Austria", "Bud": "Austria", "Ven": "Italy"},
+		testUnit{"Austria", Army, "Ser"},
+		testUnit{"Italy", Army, "Ven"},
+		testUnit{"Russia", Army, "War"},
+	)
+
+	// Italy has nothing to adjust, Russia has to disband
+	assert.Equal(t, []string{"Austria", "Russia"}, s.Outstanding())
+
+	s.Outcome = &Outcome{Kind: Solo, Winner: "Austria"}
+	assert.Empty(t, s.Outstanding())
+	assert.ErrorIs(t, s.Ready("Austria"), ErrGameFinished)
+}
+
+func TestReady_SurvivesSaving(t *testing.T) {
+	s, err := InitializeNewGame(StandardVariant)
+	assert.NoError(t, err)
+	assert.NoError(t, s.Submit("Russia", "A War - Gal"))
+	assert.NoError(t, s.Ready("Russia"))
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+	loaded := &State{}
+	assert.NoError(t, json.Unmarshal(data, loaded))
+
+	russia, _ := loaded.GetCountry("Russia")
+	assert.True(t, russia.Ready)
+	assert.Equal(t, s.submittedOrders(), loaded.submittedOrders())
+	assert.NotContains(t, loaded.Outstanding(), "Russia")
+}
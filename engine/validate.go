@@ -0,0 +1,64 @@
+package engine
+
+func (s *State) requirePhase(phases ...Phase) error {
+	if s.Finished() {
+		return ErrGameFinished
+	}
+	for _, phase := range phases {
+		if s.Phase == phase {
+			return nil
+		}
+	}
+	return &WrongPhaseError{Phase: s.Phase}
+}
+
+// validateOrder checks that a country may give an order to the unit in the
+// ordered province and that the unit is able to carry it out.
+func (s *State) validateOrder(country *Country, order Order) error {
+	position := order.GetPosition()
+	reject := func(reason error) error {
+		return &IllegalMoveError{Order: order, Reason: reason}
+	}
+
+	unit := position.Unit
+	if unit == nil {
+		return &NoUnitError{Key: position.Key}
+	}
+	if unit.Country != country {
+		return &NotYourUnitError{Country: country.Name, Owner: unit.Country.Name}
+	}
+
+	switch o := order.(type) {
+	case *MoveOrder:
+		if o.Destination == position {
+			return reject(ErrNotAdjacent)
+		}
+		if unit.Type == Fleet && o.ViaConvoy {
+			return reject(ErrFleetConvoyed)
+		}
+		if unit.Type == Army && (o.ViaConvoy || !s.World.CanMove(Army, position, o.Destination)) {
+			// armies may be convoyed between any two coastal provinces
+			if !isCoastal(position) || !isCoastal(o.Destination) {
+				return reject(ErrNotAdjacent)
+			}
+			return nil
+		}
+		if unit.Type == Fleet && len(o.Destination.Coasts) > 0 && o.Coast == NoCoast &&
+			len(s.World.ReachableCoasts(position, unit.Coast, o.Destination)) > 1 {
+			return reject(ErrCoastRequired)
+		}
+		if !s.World.canMoveTo(unit, position, o.Destination, o.Coast) {
+			return reject(ErrNotAdjacent)
+		}
+	case *SupportOrder:
+		if !s.World.CanMoveCoast(unit.Type, position, unit.Coast, o.Destination, NoCoast) {
+			return reject(ErrSupportUnreachable)
+		}
+	case *ConvoyOrder:
+		if unit.Type != Fleet || position.Type != WaterTile {
+			return reject(ErrInvalidConvoy)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateOrder_RejectedOrders(t *testing.T) {
+	tests := []struct {
+		name     string
+		add      func(s *State) error
+		expected error
+	}{
+		{"No unit", func(s *State) error { return s.AddHoldOrder("France", "Par") }, ErrNoUnit},
+		{"Unit of another country", func(s *State) error { return s.AddHoldOrder("France", "Mun") }, ErrNotYourUnit},
+		{"Army not adjacent", func(s *State) error { return s.AddMoveOrder("France", "Bur", "Tyr") }, ErrNotAdjacent},
+		{"Army to sea", func(s *State) error { return s.AddMoveOrder("France", "Bre", "MAO") }, ErrNotAdjacent},
+		{"Army inland by convoy", func(s *State) error { return s.AddMoveOrder("France", "Bre", "Mun") }, ErrNotAdjacent},
+		{"Fleet inland", func(s *State) error { return s.AddMoveOrder("France", "Gas", "Bur") }, ErrNotAdjacent},
+		{"Fleet to unreachable coast", func(s *State) error { return s.AddMoveOrder("France", "Gas", "Spa/sc") }, ErrNotAdjacent},
+		{"Fleet without coast", func(s *State) error { return s.AddMoveOrder("France", "MAO", "Spa") }, ErrCoastRequired},
+		{"Fleet via convoy", func(s *State) error { return s.AddConvoyedMoveOrder("England", "Lon", "ENG") }, ErrFleetConvoyed},
+		{"Fleet via convoy in notation", func(s *State) error { return submitDATCOrder(s, "England: F Lon - ENG via Convoy") }, ErrFleetConvoyed},
+		{"Move to own province", func(s *State) error { return s.AddMoveOrder("England", "Lon", "Lon") }, ErrNotAdjacent},
+		{"Support out of reach", func(s *State) error { return s.AddSupportOrder("England", "Lon", "Bur", "Pic") }, ErrSupportUnreachable},
+		{"Convoy by army", func(s *State) error { return s.AddConvoyOrder("France", "Bre", "Gas", "Pic") }, ErrInvalidConvoy},
+		{"Convoy from land", func(s *State) error { return s.AddConvoyOrder("France", "Gas", "Bre", "Spa") }, ErrInvalidConvoy},
+		{"Retreat in movement phase", func(s *State) error { return s.AddRetreatOrder("France", "Bre", "Pic") }, ErrWrongPhase},
+		{"Build in movement phase", func(s *State) error { return s.AddBuildOrder("France", "Par", Army) }, ErrWrongPhase},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := setupAdjudicationState(
+				testUnit{"France", Army, "Bur"},
+				testUnit{"France", Army, "Bre"},
+				testUnit{"France", Fleet, "Gas"},
+				testUnit{"France", Fleet, "MAO"},
+				testUnit{"England", Fleet, "Lon"},
+				testUnit{"Germany", Army, "Mun"},
+			)
+
+			assert.ErrorIs(t, test.add(s), test.expected)
+		})
+	}
+}
+
+func TestValidateOrder_OrdersInWrongPhase(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"France", Army, "Par"})
+	s.Phase = RetreatPhase
+
+	err := s.AddMoveOrder("France", "Par", "Bur")
+	assert.EqualError(t, err, "Order is not allowed in the Retreat phase")
+	assert.ErrorIs(t, s.AddHoldOrder("France", "Par"), ErrWrongPhase)
+}
+
+func TestValidateOrder_ArmyMayBeConvoyed(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"England", Army, "Lon"})
+
+	assert.NoError(t, s.AddMoveOrder("England", "Lon", "Nwy"))
+	assert.NoError(t, s.AddConvoyedMoveOrder("England", "Lon", "Wal"))
+}
@@ -0,0 +1,154 @@
+package engine
+
+import (
+	"encoding/json"
+	"path"
+	"sort"
+	"sync"
+)
+
+// StandardVariant is the name of the standard game.
+const StandardVariant = "standard"
+
+// Variant bundles a map with its powers and starting units, the number of
+// supply centers needed to win and the rule options a game is played with.
+type Variant struct {
+	Name           string
+	Description    string
+	Map            *MapDefinition
+	VictoryCenters int
+	ParadoxRule    ParadoxRule
+}
+
+// variantFile is how a built-in variant is written. It names one of the
+// built-in maps and may replace the powers of that map.
+type variantFile struct {
+	Name           string            `json:"name"`
+	Description    string            `json:"description"`
+	Map            string            `json:"map"`
+	Powers         []PowerDefinition `json:"powers,omitempty"`
+	VictoryCenters int               `json:"victoryCenters"`
+	ParadoxRule    string            `json:"paradoxRule"`
+}
+
+var (
+	variantsMu sync.RWMutex
+	variants   = map[string]*Variant{}
+)
+
+func init() {
+	files, err := builtin.ReadDir("variants")
+	if err != nil {
+		panic(err)
+	}
+	for _, file := range files {
+		v, err := loadBuiltinVariant(path.Join("variants", file.Name()))
+		if err == nil {
+			err = RegisterVariant(v)
+		}
+		if err != nil {
+			panic("the embedded variant " + file.Name() + " is invalid: " + err.Error())
+		}
+	}
+}
+
+func loadBuiltinVariant(name string) (*Variant, error) {
+	data, err := builtin.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	file := variantFile{}
+	err = json.Unmarshal(data, &file)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := builtinMap(file.Map)
+	if err != nil {
+		return nil, err
+	}
+	if file.Powers != nil {
+		m.Powers = file.Powers
+	}
+	paradoxRule, err := lookupName(paradoxRuleNames, "paradox rule", file.ParadoxRule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Variant{
+		Name:           file.Name,
+		Description:    file.Description,
+		Map:            m,
+		VictoryCenters: file.VictoryCenters,
+		ParadoxRule:    paradoxRule,
+	}, nil
+}
+
+// RegisterVariant makes a variant available by its name after validating its
+// map and victory condition.
+func RegisterVariant(v *Variant) error {
+	if v.Map == nil {
+		return &InvalidVariantError{Name: v.Name, Reason: ErrInvalidMap}
+	}
+	err := v.Map.Validate()
+	if err != nil {
+		return &InvalidVariantError{Name: v.Name, Reason: err}
+	}
+
+	centers := 0
+	for _, p := range v.Map.Provinces {
+		if p.SupplyCenter {
+			centers++
+		}
+	}
+	if v.VictoryCenters < 1 || v.VictoryCenters > centers {
+		return &InvalidVariantError{Name: v.Name, Reason: &TooManyVictoryCentersError{Required: v.VictoryCenters, Centers: centers}}
+	}
+
+	variantsMu.Lock()
+	defer variantsMu.Unlock()
+
+	if _, ok := variants[v.Name]; ok {
+		return &VariantExistsError{Name: v.Name}
+	}
+	variants[v.Name] = v
+	return nil
+}
+
+// GetVariant returns a registered variant.
+func GetVariant(name string) (*Variant, error) {
+	variantsMu.RLock()
+	defer variantsMu.RUnlock()
+
+	v, ok := variants[name]
+	if !ok {
+		return nil, &VariantNotFoundError{Name: name}
+	}
+	return v, nil
+}
+
+// VariantNames lists the names of all registered variants.
+func VariantNames() []string {
+	variantsMu.RLock()
+	defer variantsMu.RUnlock()
+
+	names := make([]string, 0, len(variants))
+	for name := range variants {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewGame starts a game of the variant.
+func (v *Variant) NewGame() (*State, error) {
+	game, err := v.Map.NewGame()
+	if err != nil {
+		return nil, err
+	}
+
+	game.Variant = v.Name
+	game.VictoryCenters = v.VictoryCenters
+	game.ParadoxRule = v.ParadoxRule
+	return game, nil
+}
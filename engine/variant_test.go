@@ -0,0 +1,95 @@
+package engine
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVariantNames(t *testing.T) {
+	names := VariantNames()
+	assert.Contains(t, names, StandardVariant)
+	assert.Contains(t, names, "france-austria")
+	assert.Contains(t, names, "pure")
+}
+
+func TestInitializeNewGame_Variants(t *testing.T) {
+	tests := []struct {
+		variant   string
+		countries int
+		units     int
+		victory   int
+	}{
+		{StandardVariant, 7, 22, 18},
+		{"france-austria", 2, 6, 18},
+		{"pure", 7, 7, 4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.variant, func(t *testing.T) {
+			s, err := InitializeNewGame(test.variant)
+			assert.NoError(t, err)
+			assert.Equal(t, test.variant, s.Variant)
+			assert.Equal(t, test.victory, s.VictoryCenters)
+			assert.Len(t, s.Countries, test.countries)
+			assert.Len(t, s.Snapshot().Units, test.units)
+			assert.NoError(t, s.World.Validate())
+		})
+	}
+}
+
+func TestInitializeNewGame_UnknownVariant(t *testing.T) {
+	_, err := InitializeNewGame("youngstown")
+	assert.ErrorIs(t, err, ErrVariantNotFound)
+}
+
+func TestPureVariant_Play(t *testing.T) {
+	s, err := InitializeNewGame("pure")
+	assert.NoError(t, err)
+
+	assert.NoError(t, s.AddMoveOrder("France", "Par", "Lon"))
+	assert.NoError(t, s.AddSupportOrder("Italy", "Rom", "Par", "Lon"))
+	assert.NoError(t, s.AddMoveOrder("Russia", "Mos", "Con"))
+	adjudicate(t, s)
+
+	assertUnitAt(t, s, "Lon", "France", Army)
+	assert.Equal(t, "S1901R", s.PhaseName())
+}
+
+func TestRegisterVariant(t *testing.T) {
+	m, err := ParseMap([]byte(testMap))
+	assert.NoError(t, err)
+
+	v := &Variant{Name: "test-register", Map: m, VictoryCenters: 2, ParadoxRule: AllHoldRule}
+	assert.NoError(t, RegisterVariant(v))
+	assert.ErrorIs(t, RegisterVariant(v), ErrVariantExists)
+
+	s, err := InitializeNewGame("test-register")
+	assert.NoError(t, err)
+	assert.Equal(t, AllHoldRule, s.ParadoxRule)
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+	loaded := &State{}
+	assert.NoError(t, json.Unmarshal(data, loaded))
+	assert.Equal(t, "test-register", loaded.Variant)
+	assert.Equal(t, 2, loaded.VictoryCenters)
+}
+
+func TestRegisterVariant_Invalid(t *testing.T) {
+	m, err := ParseMap([]byte(testMap))
+	assert.NoError(t, err)
+
+	err = RegisterVariant(&Variant{Name: "too-many-centers", Map: m, VictoryCenters: 3})
+	assert.ErrorIs(t, err, ErrInvalidVariant)
+	assert.ErrorIs(t, err, ErrVictoryCenters)
+
+	m.Adjacencies = m.Adjacencies[1:]
+	err = RegisterVariant(&Variant{Name: "one-way", Map: m, VictoryCenters: 1})
+	assert.ErrorIs(t, err, ErrInvalidVariant)
+	assert.ErrorIs(t, err, ErrInvalidMap)
+
+	_, err = GetVariant("one-way")
+	assert.ErrorIs(t, err, ErrVariantNotFound)
+}
@@ -0,0 +1,175 @@
+package engine
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// DefaultVictoryCenters is the number of supply centers needed for a solo
+// victory if the state does not set its own.
+const DefaultVictoryCenters = 18
+
+// OutcomeKind tells how a game ended.
+type OutcomeKind int8
+
+const (
+	Solo OutcomeKind = iota
+	Draw
+)
+
+func (o OutcomeKind) String() string {
+	switch o {
+	case Solo:
+		return "Solo"
+	case Draw:
+		return "Draw"
+	}
+	return ""
+}
+
+// Outcome records how and in which phase a game ended. A solo has a winner,
+// a draw is shared by its members.
+type Outcome struct {
+	Kind    OutcomeKind `json:"kind"`
+	Winner  string      `json:"winner,omitempty"`
+	Members []string    `json:"members,omitempty"`
+	Phase   string      `json:"phase"`
+}
+
+func (o *Outcome) String() string {
+	if o.Kind == Solo {
+		return fmt.Sprintf("Solo victory of %s in %s", o.Winner, o.Phase)
+	}
+	return fmt.Sprintf("Draw between %s in %s", strings.Join(o.Members, ", "), o.Phase)
+}
+
+// DrawProposal is a draw between the members that all surviving countries
+// have to accept. It is withdrawn when the phase is adjudicated.
+type DrawProposal struct {
+	Members  []string `json:"members"`
+	Accepted []string `json:"accepted"`
+}
+
+// Finished reports whether the game has ended in a solo or a draw.
+func (s *State) Finished() bool {
+	return s.Outcome != nil
+}
+
+// Survivors lists the countries that are not eliminated.
+func (s *State) Survivors() []string {
+	survivors := []string{}
+	for _, c := range s.Countries {
+		if c != nil && !c.Eliminated {
+			survivors = append(survivors, c.Name)
+		}
+	}
+	return survivors
+}
+
+// ProposeDraw proposes a draw between the members, or between all surviving
+// countries if none are given. The proposing country accepts it right away.
+func (s *State) ProposeDraw(country string, members ...string) error {
+	c, err := s.survivor(country)
+	if err != nil {
+		return err
+	}
+
+	if len(members) == 0 {
+		members = s.Survivors()
+	}
+	for _, member := range members {
+		_, err = s.survivor(member)
+		if err != nil {
+			return err
+		}
+	}
+
+	s.DrawProposal = &DrawProposal{Members: members, Accepted: []string{}}
+	return s.AcceptDraw(c.Name)
+}
+
+// AcceptDraw accepts the proposed draw. The game ends in the draw once every
+// surviving country has accepted it.
+func (s *State) AcceptDraw(country string) error {
+	c, err := s.survivor(country)
+	if err != nil {
+		return err
+	}
+	if s.DrawProposal == nil {
+		return ErrNoDrawProposal
+	}
+
+	if !slices.Contains(s.DrawProposal.Accepted, c.Name) {
+		s.DrawProposal.Accepted = append(s.DrawProposal.Accepted, c.Name)
+	}
+
+	for _, survivor := range s.Survivors() {
+		if !slices.Contains(s.DrawProposal.Accepted, survivor) {
+			return nil
+		}
+	}
+
+	s.Outcome = &Outcome{Kind: Draw, Members: s.DrawProposal.Members, Phase: s.PhaseName()}
+	s.DrawProposal = nil
+	s.clearOrders()
+	s.logger().Info("Draw agreed", "members", s.Outcome.Members, "phase", s.Outcome.Phase)
+	return nil
+}
+
+// RejectDraw withdraws the proposed draw.
+func (s *State) RejectDraw(country string) error {
+	_, err := s.survivor(country)
+	if err != nil {
+		return err
+	}
+	if s.DrawProposal == nil {
+		return ErrNoDrawProposal
+	}
+
+	s.DrawProposal = nil
+	return nil
+}
+
+// survivor returns a country that may still take part in the game.
+func (s *State) survivor(country string) (*Country, error) {
+	if s.Finished() {
+		return nil, ErrGameFinished
+	}
+	c, err := s.GetCountry(country)
+	if err != nil {
+		return nil, err
+	}
+	if c.Eliminated {
+		return nil, &CountryEliminatedError{Name: c.Name}
+	}
+	return c, nil
+}
+
+// evaluateOutcome eliminates the countries left without units and supply
+// centers and checks for a solo victory after the adjustments of a year.
+func (s *State) evaluateOutcome() {
+	victoryCenters := s.VictoryCenters
+	if victoryCenters == 0 {
+		victoryCenters = DefaultVictoryCenters
+	}
+
+	for _, c := range s.Countries {
+		if c == nil || c.Eliminated {
+			continue
+		}
+
+		centers := s.centers(c)
+		if centers == 0 && len(s.World.GetUnits(c.Name)) == 0 {
+			c.Eliminated = true
+			c.EliminationYear = s.Year
+			s.logger().Info("Country eliminated", "country", c.Name)
+			continue
+		}
+
+		if centers >= victoryCenters && s.Outcome == nil {
+			s.Outcome = &Outcome{Kind: Solo, Winner: c.Name, Phase: s.PhaseName()}
+			s.logger().Info("Solo victory", "country", c.Name, "centers", centers)
+		}
+	}
+}
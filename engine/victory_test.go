@@ -0,0 +1,118 @@
+package engine
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupWinterState(units ...testUnit) *State {
+	s := setupAdjudicationState(units...)
+	s.Turn = Winter
+	s.Phase = BuildPhase
+	return s
+}
+
+func TestEvaluateOutcome_Solo(t *testing.T) {
+	s := setupWinterState(testUnit{"France", Army, "Par"}, testUnit{"Germany", Army, "Ber"})
+	s.VictoryCenters = 3
+	for _, key := range []string{"Par", "Bre", "Mar"} {
+		assert.NoError(t, s.SetOwner(key, "France"))
+	}
+	assert.NoError(t, s.SetOwner("Ber", "Germany"))
+
+	report := adjudicate(t, s)
+
+	assert.True(t, s.Finished())
+	assert.Equal(t, &Outcome{Kind: Solo, Winner: "France", Phase: "W1901A"}, s.Outcome)
+	assert.Equal(t, s.Outcome, report.Outcome)
+	assert.Contains(t, report.String(), "Solo victory of France in W1901A")
+
+	_, err := s.Adjudicate()
+	assert.ErrorIs(t, err, ErrGameFinished)
+	assert.ErrorIs(t, s.AddHoldOrder("France", "Par"), ErrGameFinished)
+}
+
+func TestEvaluateOutcome_DefaultVictoryCenters(t *testing.T) {
+	s := setupWinterState(testUnit{"France", Army, "Par"})
+	for _, key := range []string{"Par", "Bre", "Mar"} {
+		assert.NoError(t, s.SetOwner(key, "France"))
+	}
+
+	adjudicate(t, s)
+
+	assert.False(t, s.Finished())
+	assert.Equal(t, "S1902M", s.PhaseName())
+}
+
+func TestEvaluateOutcome_Eliminated(t *testing.T) {
+	s := setupWinterState(testUnit{"France", Army, "Par"}, testUnit{"Italy", Army, "Pie"})
+	assert.NoError(t, s.SetOwner("Par", "France"))
+
+	adjudicate(t, s)
+
+	assert.Equal(t, []string{"France"}, s.Survivors())
+	italy, _ := s.GetCountry("Italy")
+	assert.True(t, italy.Eliminated)
+	assert.Equal(t, 1901, italy.EliminationYear)
+	assert.ErrorIs(t, s.ProposeDraw("Italy"), ErrEliminated)
+}
+
+func TestDraw_AllSurvivors(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"France", Army, "Par"}, testUnit{"Italy", Army, "Rom"})
+	for _, c := range s.Countries {
+		c.Eliminated = c.Name != "France" && c.Name != "Italy"
+	}
+	assert.NoError(t, s.AddMoveOrder("France", "Par", "Bur"))
+
+	assert.ErrorIs(t, s.AcceptDraw("France"), ErrNoDrawProposal)
+	assert.NoError(t, s.ProposeDraw("France"))
+	assert.False(t, s.Finished())
+
+	assert.NoError(t, s.AcceptDraw("Italy"))
+	assert.True(t, s.Finished())
+	assert.Equal(t, &Outcome{Kind: Draw, Members: []string{"France", "Italy"}, Phase: "S1901M"}, s.Outcome)
+	assert.Equal(t, "Draw between France, Italy in S1901M", s.Outcome.String())
+	assert.Nil(t, s.World.Provinces["Par"].Unit.Order)
+
+	assert.ErrorIs(t, s.ProposeDraw("France"), ErrGameFinished)
+}
+
+func TestDraw_RejectedAndWithdrawn(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"France", Army, "Par"}, testUnit{"Italy", Army, "Rom"})
+	for _, c := range s.Countries {
+		c.Eliminated = c.Name != "France" && c.Name != "Italy"
+	}
+
+	assert.ErrorIs(t, s.ProposeDraw("France", "France", "Germany"), ErrEliminated)
+	assert.NoError(t, s.ProposeDraw("France", "France"))
+	assert.NoError(t, s.RejectDraw("Italy"))
+	assert.Nil(t, s.DrawProposal)
+	assert.ErrorIs(t, s.AcceptDraw("France"), ErrNoDrawProposal)
+
+	assert.NoError(t, s.ProposeDraw("Italy"))
+	adjudicate(t, s)
+	assert.Nil(t, s.DrawProposal)
+	assert.False(t, s.Finished())
+}
+
+func TestOutcome_JSONRoundTrip(t *testing.T) {
+	s := setupAdjudicationState(testUnit{"France", Army, "Par"}, testUnit{"Italy", Army, "Rom"})
+	for _, c := range s.Countries {
+		c.Eliminated = c.Name != "France" && c.Name != "Italy"
+	}
+	s.Countries[0].EliminationYear = 1903
+	assert.NoError(t, s.ProposeDraw("France", "France"))
+	loaded := roundTrip(t, s)
+	assert.Equal(t, 1903, loaded.Countries[0].EliminationYear)
+	assert.Equal(t, s.DrawProposal, loaded.DrawProposal)
+	assert.Equal(t, s.Survivors(), loaded.Survivors())
+
+	assert.NoError(t, loaded.AcceptDraw("Italy"))
+	data, err := json.Marshal(loaded)
+	assert.NoError(t, err)
+	finished := &State{}
+	assert.NoError(t, json.Unmarshal(data, finished))
+	assert.Equal(t, &Outcome{Kind: Draw, Members: []string{"France"}, Phase: "S1901M"}, finished.Outcome)
+}
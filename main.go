@@ -1,15 +1,50 @@
 package main
 
-import "gostabbr/engine"
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"gostabbr/server"
+)
 
 func main() {
-	game, err := engine.InitializeNewGame()
-	if err != nil {
-		panic("this should never happen")
+	addr := flag.String("addr", "localhost:8080", "address to listen on")
+	interval := flag.Duration("interval", time.Second, "how often deadlines are checked")
+	verbose := flag.Bool("v", false, "log every adjudication decision")
+	flag.Parse()
+
+	level := slog.LevelInfo
+	if *verbose {
+		level = slog.LevelDebug
 	}
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level}))
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	srv := server.New(nil)
+	srv.Logger = logger
+	go srv.Run(ctx, *interval)
+
+	httpServer := &http.Server{Addr: *addr, Handler: srv}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			logger.Error("Shutdown failed", "error", err)
+		}
+	}()
 
-	world := game.World
-	for t := range world.Provinces {
-		println(world.Provinces[t].Name)
+	logger.Info("Listening", "addr", *addr)
+	err := httpServer.ListenAndServe()
+	if err != nil && err != http.ErrServerClosed {
+		logger.Error("Server stopped", "error", err)
+		os.Exit(1)
 	}
 }
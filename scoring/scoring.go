@@ -0,0 +1,155 @@
+// Package scoring computes the scores of finished games under the scoring
+// systems commonly used by leagues and tournaments.
+package scoring
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"gostabbr/engine"
+)
+
+var ErrGameNotFinished = errors.New("Game is not finished")
+
+// Result is what a scoring system needs to know about a finished game.
+type Result struct {
+	// Powers lists every power of the game, eliminated or not.
+	Powers []string
+	// Centers is the number of supply centers each power owned at the end.
+	Centers map[string]int
+	// Survivors lists the powers that were not eliminated.
+	Survivors []string
+	// Eliminated maps the eliminated powers to the year they were
+	// eliminated in.
+	Eliminated map[string]int
+	// TotalCenters is the number of supply centers on the map, including
+	// neutral ones.
+	TotalCenters int
+	Outcome      engine.Outcome
+}
+
+// Scores maps each power to its score.
+type Scores map[string]float64
+
+// System is a scoring system. House systems can be added by implementing it.
+type System interface {
+	Name() string
+	Score(result *Result) Scores
+}
+
+// Systems returns the built-in scoring systems.
+func Systems() []System {
+	return []System{DrawSize{}, SumOfSquares{}, Carnage{}, CDiplo{}, Tribute{}}
+}
+
+// FromState collects the result of a finished game from its countries and
+// the owners of its supply centers.
+func FromState(s *engine.State) (*Result, error) {
+	if !s.Finished() {
+		return nil, ErrGameNotFinished
+	}
+
+	result := &Result{
+		Powers:     []string{},
+		Centers:    s.CenterCounts(),
+		Survivors:  s.Survivors(),
+		Eliminated: map[string]int{},
+		Outcome:    *s.Outcome,
+	}
+	for _, c := range s.Countries {
+		if c == nil {
+			continue
+		}
+		result.Powers = append(result.Powers, c.Name)
+		if c.Eliminated {
+			result.Eliminated[c.Name] = c.EliminationYear
+		}
+	}
+	for _, p := range s.World.Provinces {
+		if p.IsSupplyCenter {
+			result.TotalCenters++
+		}
+	}
+
+	return result, nil
+}
+
+// ScoreState scores a finished game with the given system.
+func ScoreState(s *engine.State, system System) (Scores, error) {
+	result, err := FromState(s)
+	if err != nil {
+		return nil, err
+	}
+	return system.Score(result), nil
+}
+
+// soloScores gives everything to the winner of a solo.
+func soloScores(result *Result, total float64) Scores {
+	scores := Scores{}
+	for _, power := range result.Powers {
+		scores[power] = 0
+	}
+	scores[result.Outcome.Winner] = total
+	return scores
+}
+
+// rankings groups the powers by their number of supply centers, starting
+// with the board topper.
+func rankings(result *Result) [][]string {
+	return rankingsBy(result, func(string) int { return 0 })
+}
+
+// survivalRankings ranks the powers like rankings, but ranks powers with
+// equal centers by how long they survived: survivors first, then the powers
+// eliminated last.
+func survivalRankings(result *Result) [][]string {
+	return rankingsBy(result, func(power string) int {
+		if year, ok := result.Eliminated[power]; ok {
+			return year
+		}
+		return math.MaxInt
+	})
+}
+
+// rankingsBy groups the powers by their number of supply centers and then by
+// the tiebreak, higher values first.
+func rankingsBy(result *Result, tiebreak func(power string) int) [][]string {
+	powers := append([]string{}, result.Powers...)
+	sort.SliceStable(powers, func(i, j int) bool {
+		a, b := powers[i], powers[j]
+		if result.Centers[a] != result.Centers[b] {
+			return result.Centers[a] > result.Centers[b]
+		}
+		return tiebreak(a) > tiebreak(b)
+	})
+
+	groups := [][]string{}
+	for i, power := range powers {
+		previous := powers[max(i-1, 0)]
+		if i > 0 && result.Centers[power] == result.Centers[previous] && tiebreak(power) == tiebreak(previous) {
+			groups[len(groups)-1] = append(groups[len(groups)-1], power)
+			continue
+		}
+		groups = append(groups, []string{power})
+	}
+	return groups
+}
+
+// sharePositions gives each group of tied powers the average of the points of
+// the positions they take up together.
+func sharePositions(groups [][]string, points []float64) Scores {
+	scores := Scores{}
+	position := 0
+	for _, group := range groups {
+		sum := 0.0
+		for i := position; i < position+len(group) && i < len(points); i++ {
+			sum += points[i]
+		}
+		for _, power := range group {
+			scores[power] = sum / float64(len(group))
+		}
+		position += len(group)
+	}
+	return scores
+}
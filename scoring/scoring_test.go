@@ -0,0 +1,54 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gostabbr/engine"
+)
+
+// houseSystem scores one point per supply center.
+type houseSystem struct{}
+
+func (houseSystem) Name() string { return "Centers" }
+
+func (houseSystem) Score(result *Result) Scores {
+	scores := Scores{}
+	for _, power := range result.Powers {
+		scores[power] = float64(result.Centers[power])
+	}
+	return scores
+}
+
+func TestFromState(t *testing.T) {
+	s, err := engine.InitializeNewGame(engine.StandardVariant)
+	assert.NoError(t, err)
+
+	_, err = FromState(s)
+	assert.ErrorIs(t, err, ErrGameNotFinished)
+
+	assert.NoError(t, s.SetOwner("Mun", "France"))
+	assert.NoError(t, s.ProposeDraw("France"))
+	for _, power := range s.Survivors() {
+		assert.NoError(t, s.AcceptDraw(power))
+	}
+
+	result, err := FromState(s)
+	assert.NoError(t, err)
+	assert.Equal(t, powers, result.Powers)
+	assert.Equal(t, 34, result.TotalCenters)
+	assert.Equal(t, 4, result.Centers["France"])
+	assert.Equal(t, 2, result.Centers["Germany"])
+	assert.Empty(t, result.Eliminated)
+	assert.Equal(t, engine.Draw, result.Outcome.Kind)
+	assert.Len(t, result.Outcome.Members, 7)
+
+	scores, err := ScoreState(s, houseSystem{})
+	assert.NoError(t, err)
+	assert.Equal(t, float64(4), scores["Russia"])
+
+	scores, err = ScoreState(s, DrawSize{})
+	assert.NoError(t, err)
+	assert.InDelta(t, 100.0/7, scores["Turkey"], 0.001)
+}
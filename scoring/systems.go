@@ -0,0 +1,146 @@
+package scoring
+
+import "gostabbr/engine"
+
+// DrawSize splits 100 points evenly between the members of a draw. A solo
+// winner gets all of them.
+type DrawSize struct{}
+
+func (DrawSize) Name() string { return "Draw size" }
+
+func (DrawSize) Score(result *Result) Scores {
+	if result.Outcome.Kind == engine.Solo {
+		return soloScores(result, 100)
+	}
+
+	scores := Scores{}
+	for _, power := range result.Powers {
+		scores[power] = 0
+	}
+	for _, member := range result.Outcome.Members {
+		scores[member] = 100 / float64(len(result.Outcome.Members))
+	}
+	return scores
+}
+
+// SumOfSquares splits 100 points in proportion to the square of the supply
+// centers of each power. A solo winner gets all of them.
+type SumOfSquares struct{}
+
+func (SumOfSquares) Name() string { return "Sum of squares" }
+
+func (SumOfSquares) Score(result *Result) Scores {
+	if result.Outcome.Kind == engine.Solo {
+		return soloScores(result, 100)
+	}
+
+	squares := 0
+	for _, power := range result.Powers {
+		squares += result.Centers[power] * result.Centers[power]
+	}
+
+	scores := Scores{}
+	for _, power := range result.Powers {
+		scores[power] = 0
+		if squares > 0 {
+			scores[power] = 100 * float64(result.Centers[power]*result.Centers[power]) / float64(squares)
+		}
+	}
+	return scores
+}
+
+// Carnage ranks the powers by supply centers. The first of seven powers gets
+// 7000 points, the second 6000 and so on, tied powers share the points of
+// their positions, and every supply center adds one point. Eliminated powers
+// rank below the survivors, the earlier they were eliminated the lower. A solo
+// winner gets the points of all positions and all supply centers.
+type Carnage struct{}
+
+func (Carnage) Name() string { return "Carnage" }
+
+func (Carnage) Score(result *Result) Scores {
+	points := []float64{}
+	total := float64(result.TotalCenters)
+	for i := len(result.Powers); i > 0; i-- {
+		points = append(points, float64(i*1000))
+		total += float64(i * 1000)
+	}
+
+	if result.Outcome.Kind == engine.Solo {
+		return soloScores(result, total)
+	}
+
+	scores := sharePositions(survivalRankings(result), points)
+	for _, power := range result.Powers {
+		scores[power] += float64(result.Centers[power])
+	}
+	return scores
+}
+
+// CDiplo gives every power one point for playing and one per supply center.
+// The board topper gets 38 more points, the second 14 and the third 7, shared
+// between tied powers, which adds up to 100 in a standard game. A solo winner
+// gets 100 points.
+type CDiplo struct{}
+
+func (CDiplo) Name() string { return "C-Diplo" }
+
+func (CDiplo) Score(result *Result) Scores {
+	if result.Outcome.Kind == engine.Solo {
+		return soloScores(result, 100)
+	}
+
+	scores := sharePositions(rankings(result), []float64{38, 14, 7})
+	for _, power := range result.Powers {
+		scores[power] += 1 + float64(result.Centers[power])
+	}
+	return scores
+}
+
+// Tribute splits 66 points evenly between the survivors and adds one point
+// per supply center. The board topper then collects a tribute from every
+// other survivor of one point per supply center it has above six, split
+// between tied toppers and never more than the paying power has. A solo
+// winner gets 100 points.
+type Tribute struct{}
+
+func (Tribute) Name() string { return "Tribute" }
+
+func (Tribute) Score(result *Result) Scores {
+	if result.Outcome.Kind == engine.Solo {
+		return soloScores(result, 100)
+	}
+
+	scores := Scores{}
+	for _, power := range result.Powers {
+		scores[power] = float64(result.Centers[power])
+	}
+	if len(result.Survivors) == 0 {
+		return scores
+	}
+	for _, survivor := range result.Survivors {
+		scores[survivor] += 66 / float64(len(result.Survivors))
+	}
+
+	toppers := rankings(result)[0]
+	tribute := float64(result.Centers[toppers[0]] - 6)
+	if tribute <= 0 {
+		return scores
+	}
+
+	isTopper := map[string]bool{}
+	for _, topper := range toppers {
+		isTopper[topper] = true
+	}
+	for _, survivor := range result.Survivors {
+		if isTopper[survivor] {
+			continue
+		}
+		paid := min(tribute, scores[survivor])
+		scores[survivor] -= paid
+		for _, topper := range toppers {
+			scores[topper] += paid / float64(len(toppers))
+		}
+	}
+	return scores
+}
@@ -0,0 +1,124 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gostabbr/engine"
+)
+
+var powers = []string{"Austria", "England", "France", "Germany", "Italy", "Russia", "Turkey"}
+
+func drawResult(centers map[string]int, members ...string) *Result {
+	result := &Result{Powers: powers, Centers: map[string]int{}, Survivors: []string{}, TotalCenters: 34}
+	for _, power := range powers {
+		result.Centers[power] = centers[power]
+		if centers[power] > 0 {
+			result.Survivors = append(result.Survivors, power)
+		}
+	}
+	result.Outcome = engine.Outcome{Kind: engine.Draw, Members: members}
+	return result
+}
+
+func soloResult() *Result {
+	result := drawResult(map[string]int{"France": 18, "England": 10, "Italy": 6})
+	result.Outcome = engine.Outcome{Kind: engine.Solo, Winner: "France"}
+	return result
+}
+
+func fourWayDraw() *Result {
+	return drawResult(map[string]int{"England": 12, "France": 10, "Germany": 8, "Italy": 4}, "England", "France", "Germany", "Italy")
+}
+
+func assertScores(t *testing.T, expected, actual Scores) {
+	assert.Len(t, actual, len(expected))
+	for power, score := range expected {
+		assert.InDelta(t, score, actual[power], 0.001, power)
+	}
+}
+
+func TestDrawSize(t *testing.T) {
+	assertScores(t, Scores{
+		"England": 25, "France": 25, "Germany": 25, "Italy": 25,
+		"Austria": 0, "Russia": 0, "Turkey": 0,
+	}, DrawSize{}.Score(fourWayDraw()))
+
+	assertScores(t, Scores{
+		"France": 100, "England": 0, "Italy": 0, "Germany": 0,
+		"Austria": 0, "Russia": 0, "Turkey": 0,
+	}, DrawSize{}.Score(soloResult()))
+}
+
+func TestSumOfSquares(t *testing.T) {
+	assertScores(t, Scores{
+		"England": 44.444, "France": 30.864, "Germany": 19.753, "Italy": 4.938,
+		"Austria": 0, "Russia": 0, "Turkey": 0,
+	}, SumOfSquares{}.Score(fourWayDraw()))
+
+	assert.Equal(t, float64(100), SumOfSquares{}.Score(soloResult())["France"])
+}
+
+func TestCarnage(t *testing.T) {
+	assertScores(t, Scores{
+		"England": 7012, "France": 6010, "Germany": 5008, "Italy": 4004,
+		"Austria": 2000, "Russia": 2000, "Turkey": 2000,
+	}, Carnage{}.Score(fourWayDraw()))
+
+	scores := Carnage{}.Score(soloResult())
+	assert.Equal(t, float64(28034), scores["France"])
+	assert.Equal(t, float64(0), scores["England"])
+}
+
+func TestCarnage_EliminationOrder(t *testing.T) {
+	result := drawResult(map[string]int{"England": 12, "France": 10, "Germany": 8, "Italy": 4}, "England", "France", "Germany", "Italy")
+	result.Eliminated = map[string]int{"Austria": 1905, "Russia": 1903, "Turkey": 1905}
+
+	assertScores(t, Scores{
+		"England": 7012, "France": 6010, "Germany": 5008, "Italy": 4004,
+		"Austria": 2500, "Turkey": 2500, "Russia": 1000,
+	}, Carnage{}.Score(result))
+
+	// a survivor without centers ranks above the eliminated powers
+	result.Eliminated = map[string]int{"Austria": 1905, "Russia": 1903}
+	assertScores(t, Scores{
+		"England": 7012, "France": 6010, "Germany": 5008, "Italy": 4004,
+		"Turkey": 3000, "Austria": 2000, "Russia": 1000,
+	}, Carnage{}.Score(result))
+}
+
+func TestCDiplo(t *testing.T) {
+	assertScores(t, Scores{
+		"England": 51, "France": 25, "Germany": 16, "Italy": 5,
+		"Austria": 1, "Russia": 1, "Turkey": 1,
+	}, CDiplo{}.Score(fourWayDraw()))
+
+	tied := drawResult(map[string]int{"England": 12, "France": 12, "Germany": 10}, "England", "France", "Germany")
+	assertScores(t, Scores{
+		"England": 39, "France": 39, "Germany": 18,
+		"Austria": 1, "Italy": 1, "Russia": 1, "Turkey": 1,
+	}, CDiplo{}.Score(tied))
+}
+
+func TestTribute(t *testing.T) {
+	assertScores(t, Scores{
+		"England": 46.5, "France": 20.5, "Germany": 18.5, "Italy": 14.5,
+		"Austria": 0, "Russia": 0, "Turkey": 0,
+	}, Tribute{}.Score(fourWayDraw()))
+
+	even := drawResult(map[string]int{"England": 6, "France": 6, "Germany": 6, "Italy": 6, "Russia": 5, "Turkey": 5}, "England")
+	scores := Tribute{}.Score(even)
+	assert.InDelta(t, 17, scores["England"], 0.001)
+	assert.InDelta(t, 16, scores["Russia"], 0.001)
+
+	assert.Equal(t, float64(100), Tribute{}.Score(soloResult())["France"])
+}
+
+func TestSystems_Names(t *testing.T) {
+	names := []string{}
+	for _, system := range Systems() {
+		names = append(names, system.Name())
+	}
+	assert.Equal(t, []string{"Draw size", "Sum of squares", "Carnage", "C-Diplo", "Tribute"}, names)
+}
@@ -0,0 +1,539 @@
+// Package server hosts games over an HTTP JSON API. Games are kept in memory,
+// so the server runs locally without any other services.
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gostabbr/engine"
+)
+
+var (
+	ErrGameNotFound = errors.New("Game not found")
+	ErrUnauthorized = errors.New("A valid player token is required")
+	ErrPowerTaken   = errors.New("Power has already been joined")
+	ErrJoinCode     = errors.New("Join code is invalid")
+)
+
+// Server serves the games it hosts. Players join a game as a power with the
+// join code handed out when the game was created, and get a token, which they
+// send as "Authorization: Bearer <token>" to act for it.
+//
+// There are no user accounts and no TLS, so the server is meant for local
+// development and trusted networks only.
+type Server struct {
+	// Logger receives the requests handled and the progress of every game.
+	// Nothing is logged while it is nil.
+	Logger *slog.Logger
+
+	clock  engine.Clock
+	mux    *http.ServeMux
+	mutex  sync.Mutex
+	games  map[string]*hostedGame
+	nextID int
+}
+
+// hostedGame is a game with its scheduler and players. Its mutex guards the
+// state of the game.
+type hostedGame struct {
+	mutex     sync.Mutex
+	id        string
+	scheduler *engine.Scheduler
+	// joinCode has to be given to join the game. Only the creator of the
+	// game gets it.
+	joinCode string
+	// players maps tokens to the powers they play.
+	players map[string]string
+}
+
+// New creates a server without any games. Deadlines are measured by the
+// clock, or by the system clock if it is nil.
+func New(clock engine.Clock) *Server {
+	s := &Server{clock: clock, games: map[string]*hostedGame{}, nextID: 1}
+
+	s.mux = http.NewServeMux()
+	s.mux.HandleFunc("GET /games", s.listGames)
+	s.mux.HandleFunc("POST /games", s.createGame)
+	s.mux.HandleFunc("GET /games/{id}", s.getGame)
+	s.mux.HandleFunc("POST /games/{id}/players", s.joinGame)
+	s.mux.HandleFunc("GET /games/{id}/orders", s.getOrders)
+	s.mux.HandleFunc("PUT /games/{id}/orders", s.submitOrders)
+	s.mux.HandleFunc("PUT /games/{id}/ready", s.setReady(true))
+	s.mux.HandleFunc("DELETE /games/{id}/ready", s.setReady(false))
+	s.mux.HandleFunc("POST /games/{id}/extensions", s.extendDeadline)
+	s.mux.HandleFunc("GET /games/{id}/history", s.getHistory)
+	s.mux.HandleFunc("GET /games/{id}/history/{phase}", s.getPhase)
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.logger().Debug("Request", "method", r.Method, "path", r.URL.Path)
+	s.mux.ServeHTTP(w, r)
+}
+
+// Run adjudicates the phases of all games as their deadlines pass, checking
+// them at the given interval until the context is cancelled.
+func (s *Server) Run(ctx context.Context, interval time.Duration) error {
+	for {
+		s.Tick()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.after(interval):
+		}
+	}
+}
+
+// Tick adjudicates the phases of all games that are due.
+func (s *Server) Tick() {
+	s.mutex.Lock()
+	games := make([]*hostedGame, 0, len(s.games))
+	for _, g := range s.games {
+		games = append(games, g)
+	}
+	s.mutex.Unlock()
+
+	for _, g := range games {
+		g.mutex.Lock()
+		g.tick()
+		g.mutex.Unlock()
+	}
+}
+
+// tick adjudicates the current phase of the game if it is due. The game has
+// to be locked.
+func (g *hostedGame) tick() {
+	for {
+		report, err := g.scheduler.Tick()
+		if err != nil && !errors.Is(err, engine.ErrGameFinished) {
+			g.state().Logger.Error("Adjudication failed", "error", err)
+		}
+		// phases in which nobody has to act are over right away
+		if report == nil {
+			return
+		}
+	}
+}
+
+func (g *hostedGame) state() *engine.State {
+	return g.scheduler.Game.State
+}
+
+// player returns the power played by the token of the request.
+func (g *hostedGame) player(r *http.Request) (string, bool) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return "", false
+	}
+	power, ok := g.players[token]
+	return power, ok
+}
+
+func (s *Server) after(d time.Duration) <-chan time.Time {
+	if s.clock == nil {
+		return time.After(d)
+	}
+	return s.clock.After(d)
+}
+
+var discardLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+func (s *Server) logger() *slog.Logger {
+	if s.Logger == nil {
+		return discardLogger
+	}
+	return s.Logger
+}
+
+type createRequest struct {
+	Variant  string          `json:"variant"`
+	Schedule scheduleRequest `json:"schedule"`
+}
+
+// scheduleRequest sets the deadlines of a game as durations like "24h".
+type scheduleRequest struct {
+	Order         string `json:"order"`
+	Retreat       string `json:"retreat"`
+	Build         string `json:"build"`
+	Grace         string `json:"grace"`
+	Extension     string `json:"extension"`
+	MaxExtensions int    `json:"maxExtensions"`
+}
+
+func (r scheduleRequest) schedule() (engine.Schedule, error) {
+	schedule := engine.Schedule{MaxExtensions: r.MaxExtensions}
+	durations := []struct {
+		text     string
+		duration *time.Duration
+	}{
+		{r.Order, &schedule.Order},
+		{r.Retreat, &schedule.Retreat},
+		{r.Build, &schedule.Build},
+		{r.Grace, &schedule.Grace},
+		{r.Extension, &schedule.Extension},
+	}
+	for _, d := range durations {
+		if d.text == "" {
+			continue
+		}
+		parsed, err := time.ParseDuration(d.text)
+		if err != nil {
+			return schedule, err
+		}
+		*d.duration = parsed
+	}
+	return schedule, nil
+}
+
+func (s *Server) createGame(w http.ResponseWriter, r *http.Request) {
+	request := createRequest{Variant: engine.StandardVariant}
+	if !decode(w, r, &request) {
+		return
+	}
+	schedule, err := request.Schedule.schedule()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	state, err := engine.InitializeNewGame(request.Variant)
+	if err != nil {
+		writeError(w, statusOf(err), err)
+		return
+	}
+	joinCode, err := newToken()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	s.mutex.Lock()
+	id := strconv.Itoa(s.nextID)
+	s.nextID++
+	state.Logger = s.logger().With("game", id)
+	g := &hostedGame{
+		id:        id,
+		scheduler: engine.NewScheduler(engine.NewGame(state), schedule, s.clock),
+		joinCode:  joinCode,
+		players:   map[string]string{},
+	}
+	s.games[id] = g
+	s.mutex.Unlock()
+
+	s.logger().Info("Game created", "game", id, "variant", state.Variant)
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	writeJSON(w, http.StatusCreated, createResponse{gameView: newGameView(g, ""), JoinCode: joinCode})
+}
+
+// createResponse is the new game together with the code players need to
+// join it.
+type createResponse struct {
+	gameView
+	JoinCode string `json:"joinCode"`
+}
+
+func (s *Server) listGames(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	games := make([]*hostedGame, 0, len(s.games))
+	for _, g := range s.games {
+		games = append(games, g)
+	}
+	s.mutex.Unlock()
+
+	summaries := []gameSummary{}
+	for _, g := range games {
+		g.mutex.Lock()
+		summaries = append(summaries, newGameSummary(g))
+		g.mutex.Unlock()
+	}
+	sortSummaries(summaries)
+	writeJSON(w, http.StatusOK, summaries)
+}
+
+// game looks up and locks the game of the request. It has to be unlocked
+// if it is found.
+func (s *Server) game(w http.ResponseWriter, r *http.Request) (*hostedGame, bool) {
+	s.mutex.Lock()
+	g, ok := s.games[r.PathValue("id")]
+	s.mutex.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, ErrGameNotFound)
+		return nil, false
+	}
+	g.mutex.Lock()
+	return g, true
+}
+
+// playerGame looks up and locks the game of the request like game, and
+// returns the power the request is authorized for.
+func (s *Server) playerGame(w http.ResponseWriter, r *http.Request) (*hostedGame, string, bool) {
+	g, ok := s.game(w, r)
+	if !ok {
+		return nil, "", false
+	}
+	power, ok := g.player(r)
+	if !ok {
+		g.mutex.Unlock()
+		writeError(w, http.StatusUnauthorized, ErrUnauthorized)
+		return nil, "", false
+	}
+	return g, power, true
+}
+
+func (s *Server) getGame(w http.ResponseWriter, r *http.Request) {
+	g, ok := s.game(w, r)
+	if !ok {
+		return
+	}
+	defer g.mutex.Unlock()
+
+	power, _ := g.player(r)
+	writeJSON(w, http.StatusOK, newGameView(g, power))
+}
+
+type joinRequest struct {
+	Power string `json:"power"`
+	Code  string `json:"code"`
+}
+
+type joinResponse struct {
+	Power string `json:"power"`
+	Token string `json:"token"`
+}
+
+// joinGame hands out a token for an open power to anyone who knows the join
+// code of the game. The token is all that protects the power afterwards.
+func (s *Server) joinGame(w http.ResponseWriter, r *http.Request) {
+	var request joinRequest
+	if !decode(w, r, &request) {
+		return
+	}
+	g, ok := s.game(w, r)
+	if !ok {
+		return
+	}
+	defer g.mutex.Unlock()
+
+	if subtle.ConstantTimeCompare([]byte(request.Code), []byte(g.joinCode)) != 1 {
+		writeError(w, http.StatusForbidden, ErrJoinCode)
+		return
+	}
+	country, err := g.state().GetCountry(request.Power)
+	if err != nil {
+		writeError(w, statusOf(err), err)
+		return
+	}
+	if country.Eliminated {
+		err = &engine.CountryEliminatedError{Name: country.Name}
+		writeError(w, statusOf(err), err)
+		return
+	}
+	for _, power := range g.players {
+		if power == country.Name {
+			writeError(w, http.StatusConflict, ErrPowerTaken)
+			return
+		}
+	}
+
+	token, err := newToken()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	g.players[token] = country.Name
+	s.logger().Info("Power joined", "game", g.id, "power", country.Name)
+	writeJSON(w, http.StatusCreated, joinResponse{Power: country.Name, Token: token})
+}
+
+type ordersRequest struct {
+	Orders []string `json:"orders"`
+}
+
+type ordersResponse struct {
+	Power   string   `json:"power"`
+	Phase   string   `json:"phase"`
+	Orders  []string `json:"orders"`
+	Missing []string `json:"missing"`
+	Ready   bool     `json:"ready"`
+}
+
+func (s *Server) getOrders(w http.ResponseWriter, r *http.Request) {
+	g, power, ok := s.playerGame(w, r)
+	if !ok {
+		return
+	}
+	defer g.mutex.Unlock()
+
+	writeOrders(w, g, power)
+}
+
+func (s *Server) submitOrders(w http.ResponseWriter, r *http.Request) {
+	var request ordersRequest
+	if !decode(w, r, &request) {
+		return
+	}
+	g, power, ok := s.playerGame(w, r)
+	if !ok {
+		return
+	}
+	defer g.mutex.Unlock()
+
+	err := g.scheduler.Submit(power, request.Orders...)
+	if err != nil {
+		writeError(w, statusOf(err), err)
+		return
+	}
+	writeOrders(w, g, power)
+}
+
+func writeOrders(w http.ResponseWriter, g *hostedGame, power string) {
+	state := g.state()
+	orders, _ := state.Orders(power)
+	missing, _ := state.MissingOrders(power)
+	country, _ := state.GetCountry(power)
+	writeJSON(w, http.StatusOK, ordersResponse{
+		Power:   power,
+		Phase:   state.PhaseName(),
+		Orders:  orders,
+		Missing: missing,
+		Ready:   country.Ready,
+	})
+}
+
+func (s *Server) setReady(ready bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		g, power, ok := s.playerGame(w, r)
+		if !ok {
+			return
+		}
+		defer g.mutex.Unlock()
+
+		change := g.scheduler.Unready
+		if ready {
+			change = g.scheduler.Ready
+		}
+		err := change(power)
+		if err != nil {
+			writeError(w, statusOf(err), err)
+			return
+		}
+
+		// the phase ends early once every power is ready
+		g.tick()
+		writeJSON(w, http.StatusOK, newGameView(g, power))
+	}
+}
+
+func (s *Server) extendDeadline(w http.ResponseWriter, r *http.Request) {
+	g, power, ok := s.playerGame(w, r)
+	if !ok {
+		return
+	}
+	defer g.mutex.Unlock()
+
+	err := g.scheduler.Extend(power)
+	if err != nil {
+		writeError(w, statusOf(err), err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newGameView(g, power))
+}
+
+func (s *Server) getHistory(w http.ResponseWriter, r *http.Request) {
+	g, ok := s.game(w, r)
+	if !ok {
+		return
+	}
+	defer g.mutex.Unlock()
+
+	phases := []phaseView{}
+	for _, record := range g.scheduler.Game.History {
+		phases = append(phases, newPhaseView(record))
+	}
+	writeJSON(w, http.StatusOK, phases)
+}
+
+func (s *Server) getPhase(w http.ResponseWriter, r *http.Request) {
+	g, ok := s.game(w, r)
+	if !ok {
+		return
+	}
+	defer g.mutex.Unlock()
+
+	record, err := g.scheduler.Game.Phase(r.PathValue("phase"))
+	if err != nil {
+		writeError(w, statusOf(err), err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newPhaseView(record))
+}
+
+func newToken() (string, error) {
+	token := make([]byte, 16)
+	_, err := rand.Read(token)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
+
+// decode reads the JSON body of the request into v. An empty body keeps the
+// defaults.
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err != nil && !errors.Is(err, io.EOF) {
+		writeError(w, http.StatusBadRequest, err)
+		return false
+	}
+	return true
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// statuses maps the errors of the engine to HTTP status codes. Other errors
+// are caused by invalid requests.
+var statuses = []struct {
+	err    error
+	status int
+}{
+	{ErrGameNotFound, http.StatusNotFound},
+	{engine.ErrPhaseNotFound, http.StatusNotFound},
+	{engine.ErrGameFinished, http.StatusConflict},
+	{engine.ErrEliminated, http.StatusConflict},
+	{engine.ErrOrdersLocked, http.StatusConflict},
+	{engine.ErrNoExtensions, http.StatusConflict},
+	{engine.ErrWrongPhase, http.StatusConflict},
+}
+
+func statusOf(err error) int {
+	for _, s := range statuses {
+		if errors.Is(err, s.err) {
+			return s.status
+		}
+	}
+	return http.StatusBadRequest
+}
@@ -0,0 +1,210 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"gostabbr/engine"
+)
+
+// fakeClock only moves when it is advanced. The server only asks it for the
+// time in these tests.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time                         { return c.now }
+func (c *fakeClock) After(d time.Duration) <-chan time.Time { return make(chan time.Time) }
+
+func request(t *testing.T, srv *Server, method, path, token string, body any, response any) int {
+	var payload bytes.Buffer
+	if body != nil {
+		assert.NoError(t, json.NewEncoder(&payload).Encode(body))
+	}
+	r := httptest.NewRequest(method, path, &payload)
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	srv.ServeHTTP(w, r)
+
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	if response != nil {
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), response))
+	}
+	return w.Code
+}
+
+func createGame(t *testing.T, srv *Server, body any) createResponse {
+	var game createResponse
+	assert.Equal(t, http.StatusCreated, request(t, srv, "POST", "/games", "", body, &game))
+	return game
+}
+
+func join(t *testing.T, srv *Server, game createResponse, power string) string {
+	var joined joinResponse
+	body := joinRequest{Power: power, Code: game.JoinCode}
+	assert.Equal(t, http.StatusCreated, request(t, srv, "POST", "/games/"+game.ID+"/players", "", body, &joined))
+	return joined.Token
+}
+
+var powers = []string{"Austria", "England", "France", "Germany", "Italy", "Russia", "Turkey"}
+
+func TestCreateGame(t *testing.T) {
+	srv := New(nil)
+
+	game := createGame(t, srv, nil)
+	assert.Equal(t, "1", game.ID)
+	assert.Equal(t, "standard", game.Variant)
+	assert.Equal(t, "S1901M", game.Phase)
+	assert.Nil(t, game.Deadline)
+	assert.Len(t, game.Units, 22)
+	assert.Len(t, game.Powers, 7)
+
+	createGame(t, srv, createRequest{Variant: "france-austria"})
+
+	var games []gameSummary
+	assert.Equal(t, http.StatusOK, request(t, srv, "GET", "/games", "", nil, &games))
+	assert.Len(t, games, 2)
+	assert.Equal(t, "1", games[0].ID)
+	assert.Equal(t, powers, games[0].Open)
+	assert.Equal(t, []string{"Austria", "France"}, games[1].Open)
+}
+
+func TestCreateGame_Errors(t *testing.T) {
+	srv := New(nil)
+	var response errorResponse
+
+	assert.Equal(t, http.StatusBadRequest, request(t, srv, "POST", "/games", "", createRequest{Variant: "chaos"}, &response))
+	assert.Equal(t, "Variant 'chaos' not found", response.Error)
+
+	body := createRequest{Variant: "standard", Schedule: scheduleRequest{Order: "a day"}}
+	assert.Equal(t, http.StatusBadRequest, request(t, srv, "POST", "/games", "", body, nil))
+
+	assert.Equal(t, http.StatusNotFound, request(t, srv, "GET", "/games/1", "", nil, &response))
+	assert.Equal(t, "Game not found", response.Error)
+}
+
+func TestJoinGame(t *testing.T) {
+	srv := New(nil)
+	game := createGame(t, srv, nil)
+
+	token := join(t, srv, game, "France")
+	assert.NotEmpty(t, token)
+
+	assert.Equal(t, http.StatusConflict, request(t, srv, "POST", "/games/1/players", "", joinRequest{Power: "France", Code: game.JoinCode}, nil))
+	assert.Equal(t, http.StatusBadRequest, request(t, srv, "POST", "/games/1/players", "", joinRequest{Power: "Prussia", Code: game.JoinCode}, nil))
+
+	// only those who got the join code from the creator can join
+	var response errorResponse
+	assert.Equal(t, http.StatusForbidden, request(t, srv, "POST", "/games/1/players", "", joinRequest{Power: "Italy"}, &response))
+	assert.Equal(t, "Join code is invalid", response.Error)
+	assert.Equal(t, http.StatusForbidden, request(t, srv, "POST", "/games/1/players", "", joinRequest{Power: "Italy", Code: "guess"}, nil))
+
+	var view gameView
+	assert.Equal(t, http.StatusOK, request(t, srv, "GET", "/games/1", token, nil, &view))
+	assert.Equal(t, "France", view.Power)
+	assert.True(t, view.Powers[2].Joined)
+	assert.False(t, view.Powers[0].Joined)
+}
+
+func TestSubmitOrders(t *testing.T) {
+	srv := New(nil)
+	game := createGame(t, srv, nil)
+	france := join(t, srv, game, "France")
+	germany := join(t, srv, game, "Germany")
+
+	var orders ordersResponse
+	body := ordersRequest{Orders: []string{"A Par - Bur", "F Bre - MAO"}}
+	assert.Equal(t, http.StatusOK, request(t, srv, "PUT", "/games/1/orders", france, body, &orders))
+	assert.Equal(t, ordersResponse{
+		Power:   "France",
+		Phase:   "S1901M",
+		Orders:  []string{"F Bre - MAO", "A Par - Bur"},
+		Missing: []string{"Mar"},
+	}, orders)
+
+	var response errorResponse
+	body = ordersRequest{Orders: []string{"A Mar - Mun"}}
+	assert.Equal(t, http.StatusBadRequest, request(t, srv, "PUT", "/games/1/orders", france, body, &response))
+	assert.Contains(t, response.Error, "Mun")
+	assert.Equal(t, http.StatusUnauthorized, request(t, srv, "PUT", "/games/1/orders", "", body, nil))
+	assert.Equal(t, http.StatusUnauthorized, request(t, srv, "GET", "/games/1/orders", "unknown", nil, nil))
+
+	// only France sees its own orders
+	var franceView, germanyView, publicView gameView
+	request(t, srv, "GET", "/games/1", france, nil, &franceView)
+	assert.Equal(t, []string{"F Bre - MAO", "A Par - Bur"}, franceView.Orders)
+	request(t, srv, "GET", "/games/1", germany, nil, &germanyView)
+	assert.Empty(t, germanyView.Orders)
+	assert.Equal(t, []string{"Ber", "Kie", "Mun"}, germanyView.MissingOrders)
+	request(t, srv, "GET", "/games/1", "", nil, &publicView)
+	assert.Empty(t, publicView.Orders)
+	assert.Empty(t, publicView.Power)
+}
+
+func TestReady_AdjudicatesWhenAllPowersAreReady(t *testing.T) {
+	srv := New(nil)
+	game := createGame(t, srv, nil)
+	tokens := map[string]string{}
+	for _, power := range powers {
+		tokens[power] = join(t, srv, game, power)
+	}
+	body := ordersRequest{Orders: []string{"A Par - Bur"}}
+	assert.Equal(t, http.StatusOK, request(t, srv, "PUT", "/games/1/orders", tokens["France"], body, nil))
+
+	var view gameView
+	assert.Equal(t, http.StatusOK, request(t, srv, "PUT", "/games/1/ready", tokens["France"], nil, &view))
+	assert.True(t, view.Powers[2].Ready)
+	assert.Equal(t, http.StatusConflict, request(t, srv, "PUT", "/games/1/orders", tokens["France"], body, nil))
+	assert.Equal(t, http.StatusOK, request(t, srv, "DELETE", "/games/1/ready", tokens["France"], nil, &view))
+	assert.False(t, view.Powers[2].Ready)
+
+	for _, power := range powers {
+		assert.Equal(t, http.StatusOK, request(t, srv, "PUT", "/games/1/ready", tokens[power], nil, &view))
+	}
+	assert.Equal(t, "F1901M", view.Phase)
+	assert.False(t, view.Powers[2].Ready)
+
+	var history []phaseView
+	assert.Equal(t, http.StatusOK, request(t, srv, "GET", "/games/1/history", "", nil, &history))
+	assert.Len(t, history, 1)
+	assert.Equal(t, []orderView{{Power: "France", Order: "A Par - Bur"}}, history[0].Orders)
+	assert.Len(t, history[0].Results, 22)
+
+	var phase phaseView
+	assert.Equal(t, http.StatusOK, request(t, srv, "GET", "/games/1/history/S1901M", "", nil, &phase))
+	assert.Contains(t, phase.Results, resultView{Power: "France", Order: "A Par - Bur", Status: "Succeeded"})
+	assert.Contains(t, phase.After.Units, engine.UnitPosition{Country: "France", Type: engine.Army, Location: "Bur"})
+	assert.Equal(t, http.StatusNotFound, request(t, srv, "GET", "/games/1/history/F1901M", "", nil, nil))
+}
+
+func TestTick_AdjudicatesAtDeadline(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	srv := New(clock)
+	schedule := scheduleRequest{Order: "24h", Retreat: "1h", Build: "1h", Extension: "12h", MaxExtensions: 1}
+	game := createGame(t, srv, createRequest{Variant: "standard", Schedule: schedule})
+	assert.Equal(t, clock.now.Add(24*time.Hour), *game.Deadline)
+
+	token := join(t, srv, game, "Italy")
+	assert.Equal(t, http.StatusOK, request(t, srv, "POST", "/games/1/extensions", token, nil, &game))
+	assert.Equal(t, clock.now.Add(36*time.Hour), *game.Deadline)
+	assert.Equal(t, http.StatusConflict, request(t, srv, "POST", "/games/1/extensions", token, nil, nil))
+
+	clock.now = clock.now.Add(24 * time.Hour)
+	srv.Tick()
+	request(t, srv, "GET", "/games/1", "", nil, &game)
+	assert.Equal(t, "S1901M", game.Phase)
+
+	clock.now = clock.now.Add(12 * time.Hour)
+	srv.Tick()
+	request(t, srv, "GET", "/games/1", "", nil, &game)
+	assert.Equal(t, "F1901M", game.Phase)
+	assert.Equal(t, clock.now.Add(24*time.Hour), *game.Deadline)
+}
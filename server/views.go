@@ -0,0 +1,184 @@
+package server
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"gostabbr/engine"
+)
+
+// gameSummary is a game in the list of hosted games.
+type gameSummary struct {
+	ID       string   `json:"id"`
+	Variant  string   `json:"variant"`
+	Phase    string   `json:"phase"`
+	Finished bool     `json:"finished"`
+	Open     []string `json:"open"`
+}
+
+func newGameSummary(g *hostedGame) gameSummary {
+	state := g.state()
+	summary := gameSummary{ID: g.id, Variant: state.Variant, Phase: state.PhaseName(), Finished: state.Finished(), Open: []string{}}
+	for _, power := range state.Survivors() {
+		if !g.joined(power) {
+			summary.Open = append(summary.Open, power)
+		}
+	}
+	return summary
+}
+
+// sortSummaries orders the games by the order they were created in.
+func sortSummaries(summaries []gameSummary) {
+	sort.Slice(summaries, func(i, j int) bool {
+		a, _ := strconv.Atoi(summaries[i].ID)
+		b, _ := strconv.Atoi(summaries[j].ID)
+		return a < b
+	})
+}
+
+func (g *hostedGame) joined(power string) bool {
+	for _, player := range g.players {
+		if player == power {
+			return true
+		}
+	}
+	return false
+}
+
+// gameView is the state of a game as a power may see it. Pending orders are
+// only shown to the power that gave them.
+type gameView struct {
+	ID            string                `json:"id"`
+	Variant       string                `json:"variant"`
+	Phase         string                `json:"phase"`
+	Deadline      *time.Time            `json:"deadline,omitempty"`
+	Units         []engine.UnitPosition `json:"units"`
+	Dislodged     []dislodgedView       `json:"dislodged"`
+	SupplyCenters map[string]string     `json:"supplyCenters"`
+	Powers        []powerView           `json:"powers"`
+	Outcome       *engine.Outcome       `json:"outcome,omitempty"`
+	DrawProposal  *engine.DrawProposal  `json:"drawProposal,omitempty"`
+	// Power is the power of the player asking, who also sees its orders.
+	Power         string   `json:"power,omitempty"`
+	Orders        []string `json:"orders,omitempty"`
+	MissingOrders []string `json:"missingOrders,omitempty"`
+}
+
+type powerView struct {
+	Name       string `json:"name"`
+	Joined     bool   `json:"joined"`
+	Ready      bool   `json:"ready"`
+	Eliminated bool   `json:"eliminated"`
+	Centers    int    `json:"centers"`
+	Units      int    `json:"units"`
+}
+
+// dislodgedView is a dislodged unit with the provinces it may retreat to.
+type dislodgedView struct {
+	engine.UnitPosition
+	Retreats []string `json:"retreats"`
+}
+
+func newGameView(g *hostedGame, power string) gameView {
+	state := g.state()
+	snapshot := state.Snapshot()
+	view := gameView{
+		ID:            g.id,
+		Variant:       state.Variant,
+		Phase:         state.PhaseName(),
+		Units:         snapshot.Units,
+		Dislodged:     []dislodgedView{},
+		SupplyCenters: snapshot.SupplyCenters,
+		Powers:        []powerView{},
+		Outcome:       state.Outcome,
+		DrawProposal:  state.DrawProposal,
+	}
+	if deadline := g.scheduler.Deadline(); !deadline.IsZero() && !state.Finished() {
+		view.Deadline = &deadline
+	}
+
+	for i, d := range state.Dislodged {
+		dislodged := dislodgedView{UnitPosition: snapshot.Dislodged[i], Retreats: []string{}}
+		for _, p := range d.Retreats {
+			dislodged.Retreats = append(dislodged.Retreats, p.Key)
+		}
+		view.Dislodged = append(view.Dislodged, dislodged)
+	}
+
+	centers := state.CenterCounts()
+	for _, c := range state.Countries {
+		if c == nil {
+			continue
+		}
+		view.Powers = append(view.Powers, powerView{
+			Name:       c.Name,
+			Joined:     g.joined(c.Name),
+			Ready:      c.Ready,
+			Eliminated: c.Eliminated,
+			Centers:    centers[c.Name],
+			Units:      len(state.World.GetUnits(c.Name)),
+		})
+	}
+
+	if power != "" {
+		view.Power = power
+		view.Orders, _ = state.Orders(power)
+		view.MissingOrders, _ = state.MissingOrders(power)
+	}
+	return view
+}
+
+// phaseView is an adjudicated phase. All orders are public once the phase
+// is over.
+type phaseView struct {
+	Name    string       `json:"name"`
+	Orders  []orderView  `json:"orders"`
+	Results []resultView `json:"results"`
+	Before  snapshotView `json:"before"`
+	After   snapshotView `json:"after"`
+}
+
+type orderView struct {
+	Power string `json:"power"`
+	Order string `json:"order"`
+}
+
+type resultView struct {
+	Power  string `json:"power"`
+	Order  string `json:"order"`
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+}
+
+type snapshotView struct {
+	Units         []engine.UnitPosition `json:"units"`
+	Dislodged     []engine.UnitPosition `json:"dislodged"`
+	SupplyCenters map[string]string     `json:"supplyCenters"`
+}
+
+func newPhaseView(record *engine.PhaseRecord) phaseView {
+	view := phaseView{
+		Name:    record.Name,
+		Orders:  []orderView{},
+		Results: []resultView{},
+		Before:  newSnapshotView(record.Before),
+		After:   newSnapshotView(record.After),
+	}
+	for _, order := range record.Orders {
+		view.Orders = append(view.Orders, orderView{Power: order.Country, Order: order.Order})
+	}
+	for _, result := range record.Results {
+		view.Results = append(view.Results, resultView{
+			Power:  result.Country,
+			Order:  result.Text,
+			Status: result.Status.String(),
+			Reason: result.Reason,
+		})
+	}
+	return view
+}
+
+func newSnapshotView(snapshot *engine.Snapshot) snapshotView {
+	return snapshotView{Units: snapshot.Units, Dislodged: snapshot.Dislodged, SupplyCenters: snapshot.SupplyCenters}
+}